@@ -18,7 +18,7 @@ import (
 )
 
 type Bind struct {
-	SSH *ssh.SSH
+	SSH ssh.Executor
 	IP  network.IP
 }
 
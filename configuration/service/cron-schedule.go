@@ -0,0 +1,283 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package service
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+var cronMacros = map[string]string{
+	"@yearly":   "0 0 1 1 *",
+	"@annually": "0 0 1 1 *",
+	"@monthly":  "0 0 1 * *",
+	"@weekly":   "0 0 * * 0",
+	"@daily":    "0 0 * * *",
+	"@midnight": "0 0 * * *",
+	"@hourly":   "0 * * * *",
+}
+
+var cronMonthNames = []string{"jan", "feb", "mar", "apr", "may", "jun", "jul", "aug", "sep", "oct", "nov", "dec"}
+
+var cronWeekdayNames = []string{"sun", "mon", "tue", "wed", "thu", "fri", "sat"}
+
+var cronWeekdays = []string{"Sun", "Mon", "Tue", "Wed", "Thu", "Fri", "Sat"}
+
+// cronFieldSpec describes the range of a field and the value of its names.
+type cronFieldSpec struct {
+	min   int
+	max   int
+	names []string
+	// firstName is the value of the first name, like 1 for jan
+	firstName int
+}
+
+var (
+	cronMinuteSpec     = cronFieldSpec{min: 0, max: 59}
+	cronHourSpec       = cronFieldSpec{min: 0, max: 23}
+	cronDayOfMonthSpec = cronFieldSpec{min: 1, max: 31}
+	cronMonthSpec      = cronFieldSpec{min: 1, max: 12, names: cronMonthNames, firstName: 1}
+	cronDayOfWeekSpec  = cronFieldSpec{min: 0, max: 7, names: cronWeekdayNames, firstName: 0}
+)
+
+// cronField contains the allowed values of one field of a schedule.
+type cronField struct {
+	values []bool
+	min    int
+	max    int
+	// star is true if the field starts with *
+	star bool
+}
+
+func (c cronField) contains(value int) bool {
+	return value < len(c.values) && c.values[value]
+}
+
+// ParsedCronSchedule is a schedule in the format of crontab(5).
+type ParsedCronSchedule struct {
+	Minute     cronField
+	Hour       cronField
+	DayOfMonth cronField
+	Month      cronField
+	DayOfWeek  cronField
+}
+
+// ParseCronSchedule parses five fields or a macro like @daily.
+// Ranges, steps, lists and names of months and weekdays are supported.
+func ParseCronSchedule(schedule string) (*ParsedCronSchedule, error) {
+	schedule = strings.TrimSpace(schedule)
+	if strings.HasPrefix(schedule, "@") {
+		expanded, ok := cronMacros[strings.ToLower(schedule)]
+		if !ok {
+			return nil, errors.Errorf("unsupported cron macro '%s'", schedule)
+		}
+		schedule = expanded
+	}
+	parts := strings.Fields(schedule)
+	if len(parts) != 5 {
+		return nil, errors.Errorf("cron schedule '%s' needs 5 fields", schedule)
+	}
+	var err error
+	result := &ParsedCronSchedule{}
+	if result.Minute, err = parseCronField(parts[0], cronMinuteSpec); err != nil {
+		return nil, errors.Wrap(err, "parse minute failed")
+	}
+	if result.Hour, err = parseCronField(parts[1], cronHourSpec); err != nil {
+		return nil, errors.Wrap(err, "parse hour failed")
+	}
+	if result.DayOfMonth, err = parseCronField(parts[2], cronDayOfMonthSpec); err != nil {
+		return nil, errors.Wrap(err, "parse day of month failed")
+	}
+	if result.Month, err = parseCronField(parts[3], cronMonthSpec); err != nil {
+		return nil, errors.Wrap(err, "parse month failed")
+	}
+	if result.DayOfWeek, err = parseCronField(parts[4], cronDayOfWeekSpec); err != nil {
+		return nil, errors.Wrap(err, "parse day of week failed")
+	}
+	// 7 is an alias for sunday
+	if result.DayOfWeek.values[7] {
+		result.DayOfWeek.values[0] = true
+		result.DayOfWeek.values[7] = false
+	}
+	result.DayOfWeek.max = 6
+	return result, nil
+}
+
+func parseCronField(field string, spec cronFieldSpec) (cronField, error) {
+	min, max := spec.min, spec.max
+	result := cronField{
+		values: make([]bool, max+1),
+		min:    min,
+		max:    max,
+		star:   strings.HasPrefix(field, "*"),
+	}
+	for _, item := range strings.Split(field, ",") {
+		value, step := item, 1
+		if pos := strings.Index(item, "/"); pos != -1 {
+			var err error
+			value = item[:pos]
+			step, err = strconv.Atoi(item[pos+1:])
+			if err != nil || step <= 0 {
+				return cronField{}, errors.Errorf("invalid step in '%s'", item)
+			}
+		}
+		from, to := min, max
+		switch {
+		case value == "*":
+		case strings.Contains(value, "-"):
+			bounds := strings.SplitN(value, "-", 2)
+			var err error
+			if from, err = parseCronValue(bounds[0], spec); err != nil {
+				return cronField{}, err
+			}
+			if to, err = parseCronValue(bounds[1], spec); err != nil {
+				return cronField{}, err
+			}
+			if from > to {
+				return cronField{}, errors.Errorf("invalid range '%s'", item)
+			}
+		default:
+			var err error
+			if from, err = parseCronValue(value, spec); err != nil {
+				return cronField{}, err
+			}
+			if value != item {
+				// a single value with step like 5/10 means every 10 starting at 5
+				to = max
+			} else {
+				to = from
+			}
+		}
+		for i := from; i <= to; i += step {
+			result.values[i] = true
+		}
+	}
+	return result, nil
+}
+
+func parseCronValue(value string, spec cronFieldSpec) (int, error) {
+	for i, name := range spec.names {
+		if strings.ToLower(value) == name {
+			return spec.firstName + i, nil
+		}
+	}
+	number, err := strconv.Atoi(value)
+	if err != nil {
+		return 0, errors.Errorf("invalid value '%s'", value)
+	}
+	if number < spec.min || number > spec.max {
+		return 0, errors.Errorf("value '%s' out of range %d-%d", value, spec.min, spec.max)
+	}
+	return number, nil
+}
+
+// Next returns the first fire time after the given time.
+func (p *ParsedCronSchedule) Next(after time.Time) time.Time {
+	t := after.Truncate(time.Minute).Add(time.Minute)
+	// every valid schedule fires at least once within eight years (29th of february)
+	limit := t.AddDate(8, 0, 0)
+	for t.Before(limit) {
+		if !p.Month.contains(int(t.Month())) {
+			t = time.Date(t.Year(), t.Month()+1, 1, 0, 0, 0, 0, t.Location())
+			continue
+		}
+		if !p.dayMatches(t) {
+			t = time.Date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0, t.Location())
+			continue
+		}
+		if !p.Hour.contains(t.Hour()) {
+			t = time.Date(t.Year(), t.Month(), t.Day(), t.Hour()+1, 0, 0, 0, t.Location())
+			continue
+		}
+		if !p.Minute.contains(t.Minute()) {
+			t = t.Add(time.Minute)
+			continue
+		}
+		return t
+	}
+	return time.Time{}
+}
+
+// NextN returns the next n fire times after the given time.
+func (p *ParsedCronSchedule) NextN(after time.Time, n int) []time.Time {
+	var result []time.Time
+	for i := 0; i < n; i++ {
+		after = p.Next(after)
+		if after.IsZero() {
+			break
+		}
+		result = append(result, after)
+	}
+	return result
+}
+
+// dayMatches follows cron, that fires if day of month or day of week matches if both are restricted.
+func (p *ParsedCronSchedule) dayMatches(t time.Time) bool {
+	dayOfMonth := p.DayOfMonth.contains(t.Day())
+	dayOfWeek := p.DayOfWeek.contains(int(t.Weekday()))
+	if !p.DayOfMonth.star && !p.DayOfWeek.star {
+		return dayOfMonth || dayOfWeek
+	}
+	return dayOfMonth && dayOfWeek
+}
+
+// OnCalendar converts the schedule into systemd calendar events.
+// Two events are returned if day of month and day of week are restricted.
+func (p *ParsedCronSchedule) OnCalendar() []string {
+	time := fmt.Sprintf("%s:%s:00", p.Hour.onCalendar(nil), p.Minute.onCalendar(nil))
+	dayOfWeek := p.DayOfWeek.onCalendar(cronWeekdays)
+	if !p.DayOfMonth.star && !p.DayOfWeek.star {
+		return []string{
+			fmt.Sprintf("*-%s-%s %s", p.Month.onCalendar(nil), p.DayOfMonth.onCalendar(nil), time),
+			fmt.Sprintf("%s *-%s-* %s", dayOfWeek, p.Month.onCalendar(nil), time),
+		}
+	}
+	date := fmt.Sprintf("*-%s-%s %s", p.Month.onCalendar(nil), p.DayOfMonth.onCalendar(nil), time)
+	if dayOfWeek == "*" {
+		return []string{date}
+	}
+	return []string{fmt.Sprintf("%s %s", dayOfWeek, date)}
+}
+
+// onCalendar renders the values as list of ranges like 01..05,10.
+func (c cronField) onCalendar(names []string) string {
+	all := true
+	for i := c.min; i <= c.max; i++ {
+		all = all && c.values[i]
+	}
+	if all {
+		return "*"
+	}
+	format := func(value int) string {
+		if names != nil {
+			return names[value]
+		}
+		return fmt.Sprintf("%02d", value)
+	}
+	var result []string
+	for i := c.min; i <= c.max; i++ {
+		if !c.values[i] {
+			continue
+		}
+		j := i
+		for j+1 <= c.max && c.values[j+1] {
+			j++
+		}
+		switch {
+		case i == j:
+			result = append(result, format(i))
+		case j == i+1:
+			result = append(result, format(i), format(j))
+		default:
+			result = append(result, fmt.Sprintf("%s..%s", format(i), format(j)))
+		}
+		i = j
+	}
+	return strings.Join(result, ",")
+}
@@ -103,11 +103,8 @@ func (c CronExpression) String() string {
 type CronSchedule string
 
 func (c CronSchedule) Validate(ctx context.Context) error {
-	parts := strings.FieldsFunc(c.String(), func(r rune) bool {
-		return r == ' '
-	})
-	if len(parts) != 5 {
-		return errors.Errorf("cron schedule invalid")
+	if _, err := c.Parse(); err != nil {
+		return errors.Wrap(err, "cron schedule invalid")
 	}
 	return nil
 }
@@ -116,8 +113,22 @@ func (c CronSchedule) String() string {
 	return string(c)
 }
 
+// Parse the schedule.
+func (c CronSchedule) Parse() (*ParsedCronSchedule, error) {
+	return ParseCronSchedule(c.String())
+}
+
+// OnCalendar converts the schedule into systemd calendar events.
+func (c CronSchedule) OnCalendar() ([]string, error) {
+	parsed, err := c.Parse()
+	if err != nil {
+		return nil, err
+	}
+	return parsed.OnCalendar(), nil
+}
+
 type Cron struct {
-	SSH        *ssh.SSH
+	SSH        ssh.Executor
 	Expression content.HasContent
 	Name       CronName
 	Path       CronPath
@@ -6,6 +6,7 @@ package service_test
 
 import (
 	"context"
+	"time"
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
@@ -58,3 +59,111 @@ var _ = Describe("CronName", func() {
 		})
 	})
 })
+
+var _ = Describe("CronSchedule", func() {
+	var ctx context.Context
+	BeforeEach(func() {
+		ctx = context.Background()
+	})
+	Context("Validate", func() {
+		for _, schedule := range []string{
+			"* * * * *",
+			"*/5 1-3,22 * jan-mar mon-fri",
+			"0 0 1 * 7",
+			"@daily",
+			"@Hourly",
+		} {
+			schedule := schedule
+			It("accepts "+schedule, func() {
+				Expect(service.CronSchedule(schedule).Validate(ctx)).To(BeNil())
+			})
+		}
+		for _, schedule := range []string{
+			"",
+			"* * * *",
+			"60 * * * *",
+			"* 24 * * *",
+			"* * 0 * *",
+			"* * * 13 *",
+			"* * * * 8",
+			"5-1 * * * *",
+			"*/0 * * * *",
+			"* * * foo *",
+			"@reboot",
+		} {
+			schedule := schedule
+			It("rejects "+schedule, func() {
+				Expect(service.CronSchedule(schedule).Validate(ctx)).NotTo(BeNil())
+			})
+		}
+	})
+	Context("OnCalendar", func() {
+		for schedule, expected := range map[string][]string{
+			"* * * * *":           {"*-*-* *:*:00"},
+			"0 3 * * *":           {"*-*-* 03:00:00"},
+			"@daily":              {"*-*-* 00:00:00"},
+			"*/15 * * * *":        {"*-*-* *:00,15,30,45:00"},
+			"0,30 8-18 * * *":     {"*-*-* 08..18:00,30:00"},
+			"0 4 * * mon-fri":     {"Mon..Fri *-*-* 04:00:00"},
+			"0 0 1 */2 *":         {"*-01,03,05,07,09,11-01 00:00:00"},
+			"0 0 1,15 * sun":      {"*-*-01,15 00:00:00", "Sun *-*-* 00:00:00"},
+			"0 0 * * 0,6":         {"Sun,Sat *-*-* 00:00:00"},
+			"0 12 * * 7":          {"Sun *-*-* 12:00:00"},
+			"30 2 * * sat,sun":    {"Sun,Sat *-*-* 02:30:00"},
+			"0 0 29 feb *":        {"*-02-29 00:00:00"},
+			"0 22-23,0-1 * * tue": {"Tue *-*-* 00,01,22,23:00:00"},
+		} {
+			schedule, expected := schedule, expected
+			It("converts "+schedule, func() {
+				onCalendar, err := service.CronSchedule(schedule).OnCalendar()
+				Expect(err).To(BeNil())
+				Expect(onCalendar).To(Equal(expected))
+			})
+		}
+	})
+	Context("Next", func() {
+		var now time.Time
+		BeforeEach(func() {
+			now = time.Date(2026, time.October, 19, 10, 17, 30, 0, time.UTC)
+		})
+		next := func(schedule string, n int) []time.Time {
+			parsed, err := service.CronSchedule(schedule).Parse()
+			Expect(err).To(BeNil())
+			return parsed.NextN(now, n)
+		}
+		It("returns next minutes", func() {
+			Expect(next("* * * * *", 2)).To(Equal([]time.Time{
+				time.Date(2026, time.October, 19, 10, 18, 0, 0, time.UTC),
+				time.Date(2026, time.October, 19, 10, 19, 0, 0, time.UTC),
+			}))
+		})
+		It("returns next days", func() {
+			Expect(next("0 3 * * *", 2)).To(Equal([]time.Time{
+				time.Date(2026, time.October, 20, 3, 0, 0, 0, time.UTC),
+				time.Date(2026, time.October, 21, 3, 0, 0, 0, time.UTC),
+			}))
+		})
+		It("returns steps", func() {
+			Expect(next("*/20 * * * *", 3)).To(Equal([]time.Time{
+				time.Date(2026, time.October, 19, 10, 20, 0, 0, time.UTC),
+				time.Date(2026, time.October, 19, 10, 40, 0, 0, time.UTC),
+				time.Date(2026, time.October, 19, 11, 0, 0, 0, time.UTC),
+			}))
+		})
+		It("fires on day of month or day of week", func() {
+			Expect(next("0 0 1 * fri", 3)).To(Equal([]time.Time{
+				time.Date(2026, time.October, 23, 0, 0, 0, 0, time.UTC),
+				time.Date(2026, time.October, 30, 0, 0, 0, 0, time.UTC),
+				time.Date(2026, time.November, 1, 0, 0, 0, 0, time.UTC),
+			}))
+		})
+		It("finds leap day", func() {
+			Expect(next("0 0 29 feb *", 1)).To(Equal([]time.Time{
+				time.Date(2028, time.February, 29, 0, 0, 0, 0, time.UTC),
+			}))
+		})
+		It("returns nothing for impossible dates", func() {
+			Expect(next("0 0 31 feb *", 1)).To(BeEmpty())
+		})
+	})
+})
@@ -17,7 +17,7 @@ import (
 )
 
 type Directory struct {
-	SSH   *ssh.SSH
+	SSH   ssh.Executor
 	Path  file.HasPath
 	User  file.User
 	Group file.Group
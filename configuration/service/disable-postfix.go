@@ -14,7 +14,7 @@ import (
 )
 
 type DisablePostfix struct {
-	SSH *ssh.SSH
+	SSH ssh.Executor
 }
 
 func (d *DisablePostfix) Children(ctx context.Context) (world.Configurations, error) {
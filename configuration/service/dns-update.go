@@ -20,7 +20,7 @@ import (
 )
 
 type DnsUpdate struct {
-	SSH        *ssh.SSH
+	SSH        ssh.Executor
 	DnsKey     deployer.SecretValue
 	DnsPrivate deployer.SecretValue
 	DnsZone    string
@@ -54,22 +54,6 @@ func (d *DnsUpdate) Children(ctx context.Context) (world.Configurations, error)
 			Perm: 0700,
 		}),
 
-		world.NewConfiguraionBuilder().WithApplier(&remote.Directory{
-			SSH:  d.SSH,
-			Path: file.Path("/var/log/dns-update"),
-		}),
-		world.NewConfiguraionBuilder().WithApplier(&remote.Chown{
-			SSH:   d.SSH,
-			Path:  file.Path("/var/log/dns-update"),
-			User:  "root",
-			Group: "root",
-		}),
-		world.NewConfiguraionBuilder().WithApplier(&remote.Chmod{
-			SSH:  d.SSH,
-			Path: file.Path("/var/log/dns-update"),
-			Perm: 0700,
-		}),
-
 		&remote.File{
 			SSH:     d.SSH,
 			Path:    file.Path(fmt.Sprintf("/etc/dns-update/keys/%s.%s.private", d.DnsName, d.DnsZone)),
@@ -94,11 +78,16 @@ func (d *DnsUpdate) Children(ctx context.Context) (world.Configurations, error)
 			SSH:     d.SSH,
 			Package: "dnsutils",
 		}),
-		&Cron{
-			SSH:        d.SSH,
-			Name:       d.cronName(),
-			Expression: d.cronExpression(),
-			Schedule:   "* * * * *",
+		world.NewConfiguraionBuilder().WithApplier(&remote.FileAbsent{
+			SSH:  d.SSH,
+			Path: file.Path(fmt.Sprintf("/etc/cron.d/%s", d.cronName())),
+		}),
+		&Timer{
+			SSH:         d.SSH,
+			Name:        d.cronName(),
+			Description: fmt.Sprintf("update dns %s.%s", d.DnsName, d.DnsZone),
+			Expression:  d.cronExpression(),
+			Schedule:    "* * * * *",
 		},
 	}, nil
 }
@@ -114,8 +103,7 @@ func (d *DnsUpdate) cronExpression() content.HasContent {
 	fmt.Fprintf(buf, "/etc/dns-update/keys/%s.%s ", d.DnsName, d.DnsZone)
 	fmt.Fprintf(buf, "%s ", d.DnsZone)
 	fmt.Fprintf(buf, "%s ", d.DnsName)
-	fmt.Fprintf(buf, "https://ip.benjamin-borbe.de ")
-	fmt.Fprintf(buf, ">> /var/log/dns-update/%s.%s.log", d.DnsName, d.DnsZone)
+	fmt.Fprintf(buf, "https://ip.benjamin-borbe.de")
 	return content.Static(buf.String())
 }
 
@@ -0,0 +1,57 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package service_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/format"
+
+	"github.com/bborbe/world/configuration/service"
+	"github.com/bborbe/world/pkg/deployer"
+	"github.com/bborbe/world/pkg/ssh/sshtest"
+	"github.com/bborbe/world/pkg/world"
+)
+
+var _ = Describe("DnsUpdate", func() {
+	format.TruncatedDiff = false
+	var ctx context.Context
+	var err error
+	var recorder *sshtest.Recorder
+	BeforeEach(func() {
+		ctx = context.Background()
+		recorder = &sshtest.Recorder{
+			Responses: []sshtest.Response{
+				{
+					Pattern: `md5sum -c|^test -d`,
+					Fail:    true,
+				},
+			},
+		}
+		builder := world.Builder{
+			Configuration: &service.DnsUpdate{
+				SSH:        recorder,
+				DnsKey:     deployer.SecretValueStatic("key"),
+				DnsPrivate: deployer.SecretValueStatic("private"),
+				DnsName:    "home",
+				DnsZone:    "example.com",
+			},
+		}
+		runner, err := builder.Build(ctx)
+		Expect(err).To(BeNil())
+		err = runner.Apply(ctx)
+	})
+	It("returns no error", func() {
+		Expect(err).To(BeNil())
+	})
+	It("sends expected commands", func() {
+		transcript := recorder.Transcript()
+		golden, err := sshtest.ReadGolden("testdata/dns-update.golden", transcript)
+		Expect(err).To(BeNil())
+		Expect(transcript).To(Equal(golden))
+	})
+})
@@ -6,8 +6,12 @@ package service
 
 import (
 	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
 
 	"github.com/bborbe/world/pkg/apt"
+	"github.com/bborbe/world/pkg/facts"
 	"github.com/bborbe/world/pkg/remote"
 	"github.com/bborbe/world/pkg/ssh"
 	"github.com/bborbe/world/pkg/validation"
@@ -15,30 +19,50 @@ import (
 )
 
 type DockerEngine struct {
-	SSH *ssh.SSH
+	SSH ssh.Executor
 }
 
 func (d *DockerEngine) Children(ctx context.Context) (world.Configurations, error) {
 	return world.Configurations{
-		world.NewConfiguraionBuilder().WithApplier(&remote.Command{
+		world.NewConfiguraionBuilder().WithApplier(&apt.Install{
+			SSH:     d.SSH,
+			Package: "ca-certificates",
+		}),
+		world.NewConfiguraionBuilder().WithApplier(&apt.Install{
 			SSH:     d.SSH,
-			Command: "curl -fsSL https://download.docker.com/linux/ubuntu/gpg | apt-key add -",
+			Package: "curl",
 		}),
 		world.NewConfiguraionBuilder().WithApplier(&apt.Install{
 			SSH:     d.SSH,
-			Package: "software-properties-common",
+			Package: "gnupg",
+		}),
+		world.NewConfiguraionBuilder().WithApplierBuildFunc(func(ctx context.Context) (world.Applier, error) {
+			f, err := facts.Get(ctx, d.SSH)
+			if err != nil {
+				return nil, errors.Wrap(err, "get facts failed")
+			}
+			return &apt.Repository{
+				SSH:        d.SSH,
+				Name:       "docker",
+				KeyURL:     fmt.Sprintf("https://download.docker.com/linux/%s/gpg", dockerDistribution(f)),
+				URL:        fmt.Sprintf("https://download.docker.com/linux/%s", dockerDistribution(f)),
+				Components: []string{"stable"},
+			}, nil
 		}),
-		world.NewConfiguraionBuilder().WithApplier(&remote.Command{
+		world.NewConfiguraionBuilder().WithApplier(&apt.Update{
+			SSH: d.SSH,
+		}),
+		world.NewConfiguraionBuilder().WithApplier(&apt.Install{
 			SSH:     d.SSH,
-			Command: `add-apt-repository "deb [arch=amd64] https://download.docker.com/linux/ubuntu $(lsb_release -cs) stable"`,
+			Package: "docker-ce",
 		}),
-		world.NewConfiguraionBuilder().WithApplier(&remote.Command{
+		world.NewConfiguraionBuilder().WithApplier(&apt.Install{
 			SSH:     d.SSH,
-			Command: `apt-get --quiet --yes update`,
+			Package: "docker-ce-cli",
 		}),
-		world.NewConfiguraionBuilder().WithApplier(&remote.Command{
+		world.NewConfiguraionBuilder().WithApplier(&apt.Install{
 			SSH:     d.SSH,
-			Command: `apt-get --quiet --yes --no-install-recommends install docker-ce docker-ce-cli containerd.io`,
+			Package: "containerd.io",
 		}),
 	}, nil
 }
@@ -56,3 +80,11 @@ func (d *DockerEngine) Validate(ctx context.Context) error {
 		d.SSH,
 	)
 }
+
+// dockerDistribution returns the distribution name used by download.docker.com.
+func dockerDistribution(f *facts.Facts) string {
+	if f.OS.ID == "" {
+		return "ubuntu"
+	}
+	return f.OS.ID
+}
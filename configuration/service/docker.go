@@ -40,7 +40,7 @@ func (m Memory) Int() int {
 }
 
 type Docker struct {
-	SSH                       *ssh.SSH
+	SSH                       ssh.Executor
 	Name                      remote.ServiceName
 	BuildDockerServiceContent func(ctx context.Context) (*DockerServiceContent, error)
 }
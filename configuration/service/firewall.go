@@ -0,0 +1,298 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package service
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/bborbe/world/pkg/content"
+	"github.com/bborbe/world/pkg/network"
+	"github.com/bborbe/world/pkg/remote"
+	"github.com/bborbe/world/pkg/ssh"
+	"github.com/bborbe/world/pkg/validation"
+	"github.com/bborbe/world/pkg/world"
+)
+
+type FirewallChain string
+
+func (f FirewallChain) String() string {
+	return string(f)
+}
+
+func (f FirewallChain) Validate(ctx context.Context) error {
+	if f == "" {
+		return errors.New("FirewallChain missing")
+	}
+	return nil
+}
+
+const (
+	FirewallChainInput       FirewallChain = "INPUT"
+	FirewallChainForward     FirewallChain = "FORWARD"
+	FirewallChainOutput      FirewallChain = "OUTPUT"
+	FirewallChainPrerouting  FirewallChain = "PREROUTING"
+	FirewallChainPostrouting FirewallChain = "POSTROUTING"
+)
+
+type FirewallPolicy string
+
+func (f FirewallPolicy) Validate(ctx context.Context) error {
+	switch f {
+	case FirewallPolicyAccept, FirewallPolicyDrop:
+		return nil
+	default:
+		return errors.Errorf("invalid policy '%s'", f)
+	}
+}
+
+const (
+	FirewallPolicyAccept FirewallPolicy = "ACCEPT"
+	FirewallPolicyDrop   FirewallPolicy = "DROP"
+)
+
+type FirewallTarget string
+
+func (f FirewallTarget) Validate(ctx context.Context) error {
+	if f == "" {
+		return errors.New("FirewallTarget missing")
+	}
+	return nil
+}
+
+const (
+	FirewallTargetAccept     FirewallTarget = "ACCEPT"
+	FirewallTargetDrop       FirewallTarget = "DROP"
+	FirewallTargetReject     FirewallTarget = "REJECT"
+	FirewallTargetMasquerade FirewallTarget = "MASQUERADE"
+	FirewallTargetDNAT       FirewallTarget = "DNAT"
+)
+
+type FirewallRule struct {
+	Chain        FirewallChain
+	Source       network.IPNet
+	Destination  network.IPNet
+	InInterface  network.Device
+	OutInterface network.Device
+	Protocol     network.Protocol
+	Port         network.Port
+	PortRange    *network.PortRange
+	// State like NEW or RELATED,ESTABLISHED
+	State  string
+	Target FirewallTarget
+	// ToDestination of DNAT rules like 10.0.0.1:80
+	ToDestination string
+}
+
+func (f FirewallRule) Validate(ctx context.Context) error {
+	if (f.Port != nil || f.PortRange != nil) && f.Protocol == "" {
+		return errors.New("Protocol required for Port")
+	}
+	if f.Port != nil && f.PortRange != nil {
+		return errors.New("only one of Port and PortRange allowed")
+	}
+	if f.Protocol != "" {
+		if err := f.Protocol.Validate(ctx); err != nil {
+			return err
+		}
+	}
+	if f.Target == FirewallTargetDNAT && f.ToDestination == "" {
+		return errors.New("ToDestination required for DNAT")
+	}
+	return validation.Validate(
+		ctx,
+		f.Chain,
+		f.Target,
+	)
+}
+
+// Render the rule in the format of iptables-save.
+func (f FirewallRule) Render(ctx context.Context) (string, error) {
+	buf := &bytes.Buffer{}
+	fmt.Fprintf(buf, "-A %s", f.Chain)
+	if f.Source != nil {
+		ipNet, err := f.Source.IPNet(ctx)
+		if err != nil {
+			return "", errors.Wrap(err, "get source failed")
+		}
+		fmt.Fprintf(buf, " -s %s", ipNet.String())
+	}
+	if f.Destination != nil {
+		ipNet, err := f.Destination.IPNet(ctx)
+		if err != nil {
+			return "", errors.Wrap(err, "get destination failed")
+		}
+		fmt.Fprintf(buf, " -d %s", ipNet.String())
+	}
+	if f.InInterface != "" {
+		fmt.Fprintf(buf, " -i %s", f.InInterface)
+	}
+	if f.OutInterface != "" {
+		fmt.Fprintf(buf, " -o %s", f.OutInterface)
+	}
+	if f.Protocol != "" {
+		fmt.Fprintf(buf, " -p %s", f.Protocol)
+	}
+	if f.State != "" {
+		fmt.Fprintf(buf, " -m state --state %s", f.State)
+	}
+	ports, err := f.ports(ctx)
+	if err != nil {
+		return "", err
+	}
+	if ports != "" {
+		fmt.Fprintf(buf, " -m %s --dport %s", f.Protocol, ports)
+	}
+	fmt.Fprintf(buf, " -j %s", f.Target)
+	if f.ToDestination != "" {
+		fmt.Fprintf(buf, " --to-destination %s", f.ToDestination)
+	}
+	return buf.String(), nil
+}
+
+func (f FirewallRule) ports(ctx context.Context) (string, error) {
+	if f.Port != nil {
+		port, err := f.Port.Port(ctx)
+		if err != nil {
+			return "", errors.Wrap(err, "get port failed")
+		}
+		return strconv.Itoa(port), nil
+	}
+	if f.PortRange != nil {
+		from, err := f.PortRange.From.Port(ctx)
+		if err != nil {
+			return "", errors.Wrap(err, "get port failed")
+		}
+		to, err := f.PortRange.To.Port(ctx)
+		if err != nil {
+			return "", errors.Wrap(err, "get port failed")
+		}
+		return fmt.Sprintf("%d:%d", from, to), nil
+	}
+	return "", nil
+}
+
+type FirewallRules []FirewallRule
+
+func (f FirewallRules) Validate(ctx context.Context) error {
+	for _, rule := range f {
+		if err := rule.Validate(ctx); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// FirewallTable is the complete content of one iptables table.
+// Builtin chains without policy default to ACCEPT.
+type FirewallTable struct {
+	Policies map[FirewallChain]FirewallPolicy
+	Chains   []FirewallChain
+	Rules    FirewallRules
+}
+
+func (f FirewallTable) Validate(ctx context.Context) error {
+	for chain, policy := range f.Policies {
+		if err := validation.Validate(ctx, chain, policy); err != nil {
+			return err
+		}
+	}
+	for _, chain := range f.Chains {
+		if err := chain.Validate(ctx); err != nil {
+			return err
+		}
+	}
+	return f.Rules.Validate(ctx)
+}
+
+func (f FirewallTable) render(ctx context.Context, buf *bytes.Buffer, name string, builtins []FirewallChain) error {
+	fmt.Fprintf(buf, "*%s\n", name)
+	for _, chain := range builtins {
+		policy, ok := f.Policies[chain]
+		if !ok {
+			policy = FirewallPolicyAccept
+		}
+		fmt.Fprintf(buf, ":%s %s [0:0]\n", chain, policy)
+	}
+	chains := make([]string, 0, len(f.Chains))
+	for _, chain := range f.Chains {
+		chains = append(chains, chain.String())
+	}
+	sort.Strings(chains)
+	for _, chain := range chains {
+		fmt.Fprintf(buf, ":%s - [0:0]\n", chain)
+	}
+	for _, rule := range f.Rules {
+		line, err := rule.Render(ctx)
+		if err != nil {
+			return errors.Wrapf(err, "render rule of table %s failed", name)
+		}
+		fmt.Fprintln(buf, line)
+	}
+	fmt.Fprintln(buf, "COMMIT")
+	return nil
+}
+
+// Firewall manages the complete iptables ruleset of the host.
+// Rules not listed here are removed, including rules added by other tools like docker.
+type Firewall struct {
+	SSH    ssh.Executor
+	Filter FirewallTable
+	Nat    FirewallTable
+	// RollbackTimeout after that the previous rules are restored if ssh is lost.
+	RollbackTimeout time.Duration
+}
+
+func (f *Firewall) Children(ctx context.Context) (world.Configurations, error) {
+	return world.Configurations{
+		&Iptables{
+			SSH: f.SSH,
+		},
+	}, nil
+}
+
+func (f *Firewall) Applier() (world.Applier, error) {
+	return &remote.IptablesRestore{
+		SSH:             f.SSH,
+		Content:         content.Func(f.Content),
+		RollbackTimeout: f.RollbackTimeout,
+	}, nil
+}
+
+func (f *Firewall) Validate(ctx context.Context) error {
+	return validation.Validate(
+		ctx,
+		f.SSH,
+		f.Filter,
+		f.Nat,
+	)
+}
+
+// Content returns the ruleset in the format of iptables-restore.
+func (f *Firewall) Content(ctx context.Context) ([]byte, error) {
+	buf := &bytes.Buffer{}
+	if err := f.Filter.render(ctx, buf, "filter", []FirewallChain{
+		FirewallChainInput,
+		FirewallChainForward,
+		FirewallChainOutput,
+	}); err != nil {
+		return nil, err
+	}
+	if err := f.Nat.render(ctx, buf, "nat", []FirewallChain{
+		FirewallChainPrerouting,
+		FirewallChainInput,
+		FirewallChainOutput,
+		FirewallChainPostrouting,
+	}); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
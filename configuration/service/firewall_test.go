@@ -0,0 +1,124 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package service_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/bborbe/world/configuration/service"
+	"github.com/bborbe/world/pkg/network"
+	"github.com/bborbe/world/pkg/remote"
+	"github.com/bborbe/world/pkg/ssh/sshtest"
+)
+
+var _ = Describe("Firewall", func() {
+	var ctx context.Context
+	var firewall *service.Firewall
+	BeforeEach(func() {
+		ctx = context.Background()
+		firewall = &service.Firewall{
+			SSH: &sshtest.Recorder{},
+			Filter: service.FirewallTable{
+				Policies: map[service.FirewallChain]service.FirewallPolicy{
+					service.FirewallChainInput:   service.FirewallPolicyDrop,
+					service.FirewallChainForward: service.FirewallPolicyDrop,
+				},
+				Rules: service.FirewallRules{
+					{
+						Chain:       service.FirewallChainInput,
+						InInterface: "lo",
+						Target:      service.FirewallTargetAccept,
+					},
+					{
+						Chain:  service.FirewallChainInput,
+						State:  "RELATED,ESTABLISHED",
+						Target: service.FirewallTargetAccept,
+					},
+					{
+						Chain:    service.FirewallChainInput,
+						Protocol: network.TCP,
+						State:    "NEW",
+						Port:     network.PortStatic(22),
+						Target:   service.FirewallTargetAccept,
+					},
+					{
+						Chain:    service.FirewallChainInput,
+						Protocol: network.UDP,
+						PortRange: &network.PortRange{
+							From: network.PortStatic(60000),
+							To:   network.PortStatic(61000),
+						},
+						Target: service.FirewallTargetAccept,
+					},
+				},
+			},
+			Nat: service.FirewallTable{
+				Rules: service.FirewallRules{
+					{
+						Chain:        service.FirewallChainPostrouting,
+						Source:       network.IPNetStatic("172.16.90.0/24"),
+						OutInterface: "eth0",
+						Target:       service.FirewallTargetMasquerade,
+					},
+				},
+			},
+		}
+	})
+	It("is valid", func() {
+		Expect(firewall.Validate(ctx)).To(BeNil())
+	})
+	It("rejects port without protocol", func() {
+		firewall.Filter.Rules[0].Port = network.PortStatic(80)
+		Expect(firewall.Validate(ctx)).NotTo(BeNil())
+	})
+	It("renders iptables-restore content", func() {
+		content, err := firewall.Content(ctx)
+		Expect(err).To(BeNil())
+		Expect(string(content)).To(Equal(`*filter
+:INPUT DROP [0:0]
+:FORWARD DROP [0:0]
+:OUTPUT ACCEPT [0:0]
+-A INPUT -i lo -j ACCEPT
+-A INPUT -m state --state RELATED,ESTABLISHED -j ACCEPT
+-A INPUT -p tcp -m state --state NEW -m tcp --dport 22 -j ACCEPT
+-A INPUT -p udp -m udp --dport 60000:61000 -j ACCEPT
+COMMIT
+*nat
+:PREROUTING ACCEPT [0:0]
+:INPUT ACCEPT [0:0]
+:OUTPUT ACCEPT [0:0]
+:POSTROUTING ACCEPT [0:0]
+-A POSTROUTING -s 172.16.90.0/24 -o eth0 -j MASQUERADE
+COMMIT
+`))
+	})
+	It("equals live ruleset of iptables-save", func() {
+		content, err := firewall.Content(ctx)
+		Expect(err).To(BeNil())
+		live := `# Generated by iptables-save v1.8.7 on Mon Jan  1 00:00:00 2024
+*filter
+:INPUT DROP [1234:5678]
+:OUTPUT ACCEPT [10:20]
+:FORWARD DROP [0:0]
+-A INPUT -i lo -j ACCEPT
+-A INPUT -m state --state RELATED,ESTABLISHED -j ACCEPT
+-A INPUT -p tcp -m state --state NEW -m tcp --dport 22 -j ACCEPT
+-A INPUT -p udp -m udp --dport 60000:61000 -j ACCEPT
+COMMIT
+# Completed on Mon Jan  1 00:00:00 2024
+*nat
+:PREROUTING ACCEPT [0:0]
+:INPUT ACCEPT [0:0]
+:OUTPUT ACCEPT [0:0]
+:POSTROUTING ACCEPT [3:180]
+-A POSTROUTING -s 172.16.90.0/24 -o eth0 -j MASQUERADE
+COMMIT
+`
+		Expect(remote.NormalizeIptables([]byte(live))).To(Equal(remote.NormalizeIptables(content)))
+	})
+})
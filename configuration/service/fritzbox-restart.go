@@ -12,6 +12,8 @@ import (
 	"github.com/bborbe/world/pkg/apt"
 	"github.com/bborbe/world/pkg/content"
 	"github.com/bborbe/world/pkg/deployer"
+	"github.com/bborbe/world/pkg/file"
+	"github.com/bborbe/world/pkg/remote"
 	"github.com/bborbe/world/pkg/ssh"
 	"github.com/bborbe/world/pkg/template"
 	"github.com/bborbe/world/pkg/validation"
@@ -19,7 +21,7 @@ import (
 )
 
 type FritzBoxRestart struct {
-	SSH              *ssh.SSH
+	SSH              ssh.Executor
 	FritzBoxUser     deployer.SecretValue
 	FritzBoxPassword deployer.SecretValue
 }
@@ -30,11 +32,16 @@ func (d *FritzBoxRestart) Children(ctx context.Context) (world.Configurations, e
 			SSH:     d.SSH,
 			Package: "curl",
 		}),
-		&Cron{
-			SSH:        d.SSH,
-			Name:       "fritzbox-restart",
-			Expression: d.cronExpression(),
-			Schedule:   "0 3 * * *",
+		world.NewConfiguraionBuilder().WithApplier(&remote.FileAbsent{
+			SSH:  d.SSH,
+			Path: file.Path("/etc/cron.d/fritzbox-restart"),
+		}),
+		&Timer{
+			SSH:         d.SSH,
+			Name:        "fritzbox-restart",
+			Description: "restart fritzbox",
+			Expression:  d.cronExpression(),
+			Schedule:    "0 3 * * *",
 		},
 	}, nil
 }
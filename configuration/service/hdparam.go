@@ -16,24 +16,27 @@ import (
 )
 
 type HdParam struct {
-	SSH *ssh.SSH
+	SSH ssh.Executor
 }
 
 func (h *HdParam) Children(ctx context.Context) (world.Configurations, error) {
 	return world.Configurations{
 		&remote.File{
 			SSH:  h.SSH,
-			Path: file.Path("/etc/udev/rules.d/69-hdparm.rules\n"),
+			Path: file.Path("/etc/udev/rules.d/69-hdparm.rules"),
 			Content: content.Static(`ACTION=="add|change", KERNEL=="sd[a-z]", ATTRS{queue/rotational}=="1", RUN+="/usr/sbin/hdparm -S 60 /dev/%k"
 `),
 			User:  "root",
 			Group: "root",
 			Perm:  0644,
+			Notify: remote.Handlers{
+				{
+					SSH:     h.SSH,
+					Name:    "reload udev rules",
+					Command: "udevadm control --reload-rules && udevadm trigger",
+				},
+			},
 		},
-		world.NewConfiguraionBuilder().WithApplier(&remote.Command{
-			SSH:     h.SSH,
-			Command: `udevadm control --reload-rules && udevadm trigger`,
-		}),
 	}, nil
 }
 
@@ -17,7 +17,7 @@ import (
 )
 
 type Ip struct {
-	SSH  *ssh.SSH
+	SSH  ssh.Executor
 	Tag  docker.Tag
 	Port network.Port
 }
@@ -14,7 +14,7 @@ import (
 )
 
 type Iptables struct {
-	SSH *ssh.SSH
+	SSH ssh.Executor
 }
 
 func (d *Iptables) Children(ctx context.Context) (world.Configurations, error) {
@@ -19,7 +19,7 @@ import (
 )
 
 type Ldap struct {
-	SSH          *ssh.SSH
+	SSH          ssh.Executor
 	Tag          docker.Tag
 	LdapPassword deployer.SecretValue
 	Requirements []world.Configuration
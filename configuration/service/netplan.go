@@ -21,7 +21,7 @@ import (
 )
 
 type NetPlan struct {
-	SSH     *ssh.SSH
+	SSH     ssh.Executor
 	Gateway network.IP
 	IP      network.IP
 	IPMask  network.IPMask
@@ -21,7 +21,7 @@ import (
 )
 
 type NginxProxy struct {
-	SSH              *ssh.SSH
+	SSH              ssh.Executor
 	Domain           network.Host
 	Target           string
 	Requirements     []world.Configuration
@@ -101,14 +101,11 @@ server {
 					IP:               ip.String(),
 				})
 			}),
-			User:  "root",
-			Group: "root",
-			Perm:  0664,
+			User:   "root",
+			Group:  "root",
+			Perm:   0664,
+			Notify: remote.Handlers{remote.RestartHandler(s.SSH, "nginx")},
 		},
-		world.NewConfiguraionBuilder().WithApplier(&remote.Command{
-			SSH:     s.SSH,
-			Command: "systemctl restart nginx",
-		}),
 	}
 }
 
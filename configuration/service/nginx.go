@@ -17,7 +17,7 @@ import (
 )
 
 type Nginx struct {
-	SSH *ssh.SSH
+	SSH ssh.Executor
 }
 
 func (s *Nginx) Validate(ctx context.Context) error {
@@ -46,14 +46,11 @@ func (s *Nginx) Children(ctx context.Context) (world.Configurations, error) {
 			Path: file.Path("/etc/nginx/conf.d/letsencrypt.conf"),
 			Content: content.Static(`
 `),
-			User:  "root",
-			Group: "root",
-			Perm:  0664,
+			User:   "root",
+			Group:  "root",
+			Perm:   0664,
+			Notify: remote.Handlers{remote.RestartHandler(s.SSH, "nginx")},
 		},
-		world.NewConfiguraionBuilder().WithApplier(&remote.Command{
-			SSH:     s.SSH,
-			Command: "systemctl restart nginx",
-		}),
 	}, nil
 }
 
@@ -15,7 +15,7 @@ import (
 )
 
 type NtpDate struct {
-	SSH *ssh.SSH
+	SSH ssh.Executor
 }
 
 func (d *NtpDate) Children(ctx context.Context) (world.Configurations, error) {
@@ -20,7 +20,7 @@ import (
 )
 
 type Poste struct {
-	SSH          *ssh.SSH
+	SSH          ssh.Executor
 	PosteVersion docker.Tag
 	Port         network.Port
 	Requirements []world.Configuration
@@ -21,7 +21,7 @@ import (
 )
 
 type Screego struct {
-	SSH     *ssh.SSH
+	SSH     ssh.Executor
 	Version docker.Tag
 	IP      network.IP
 }
@@ -17,34 +17,28 @@ import (
 )
 
 type Service struct {
-	SSH     *ssh.SSH
+	SSH     ssh.Executor
 	Name    remote.ServiceName
 	Content content.HasContent
 }
 
 func (s *Service) Children(ctx context.Context) (world.Configurations, error) {
-	return world.Configurations{
-		&remote.File{
-			SSH:     s.SSH,
-			Path:    file.Path(fmt.Sprintf("/etc/systemd/system/%s.service", s.Name)),
-			Content: s.Content,
-			User:    "root",
-			Group:   "root",
-			Perm:    0664,
-		},
-		world.NewConfiguraionBuilder().WithApplier(&remote.Command{
-			SSH:     s.SSH,
-			Command: "systemctl daemon-reload",
-		}),
-		world.NewConfiguraionBuilder().WithApplier(&remote.ServiceStart{
-			SSH:  s.SSH,
-			Name: s.Name,
-		}),
-	}, nil
+	return nil, nil
 }
 
 func (s *Service) Applier() (world.Applier, error) {
-	return nil, nil
+	return &remote.Unit{
+		SSH:  s.SSH,
+		Name: s.Name,
+		Files: []remote.UnitFile{
+			{
+				Path:    file.Path(fmt.Sprintf("/etc/systemd/system/%s.service", s.Name)),
+				Content: s.Content,
+			},
+		},
+		Enable: true,
+		Start:  true,
+	}, nil
 }
 
 func (s *Service) Validate(ctx context.Context) error {
@@ -16,7 +16,7 @@ import (
 )
 
 type Sudoers struct {
-	SSH *ssh.SSH
+	SSH ssh.Executor
 }
 
 func (d *Sudoers) Children(ctx context.Context) (world.Configurations, error) {
@@ -42,7 +42,7 @@ func (s SysctlOptions) Validate(ctx context.Context) error {
 }
 
 type Sysctl struct {
-	SSH     *ssh.SSH
+	SSH     ssh.Executor
 	Options SysctlOptions
 }
 
@@ -0,0 +1,184 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/bborbe/world/pkg/content"
+	"github.com/bborbe/world/pkg/file"
+	"github.com/bborbe/world/pkg/remote"
+	"github.com/bborbe/world/pkg/ssh"
+	"github.com/bborbe/world/pkg/systemd"
+	"github.com/bborbe/world/pkg/validation"
+	"github.com/bborbe/world/pkg/world"
+)
+
+const timerScriptDirectory = "/usr/local/lib/world"
+
+// Timer runs the expression with a systemd timer instead of cron.
+// The output is written to the journal and a failure triggers the OnFailure command.
+type Timer struct {
+	SSH             ssh.Executor
+	Name            CronName
+	Description     string
+	Expression      content.HasContent
+	Schedule        CronSchedule
+	User            CronUser
+	RandomizedDelay time.Duration
+	// OnFailure is executed if the expression fails. Default logs an error to the journal.
+	OnFailure content.HasContent
+}
+
+// Children deploys the scripts before the units. The units only reference the script paths,
+// so the scripts must not be children of the unit applier, which is skipped once satisfied.
+func (t *Timer) Children(ctx context.Context) (world.Configurations, error) {
+	unit, err := t.unit()
+	if err != nil {
+		return nil, err
+	}
+	return world.Configurations{
+		world.NewConfiguraionBuilder().WithApplier(&remote.Directory{
+			SSH:  t.SSH,
+			Path: file.Path(timerScriptDirectory),
+		}),
+		&remote.File{
+			SSH:     t.SSH,
+			Path:    t.scriptPath(t.Name.String()),
+			Content: t.script(t.Expression),
+			User:    file.User(t.User.String()),
+			Group:   "root",
+			Perm:    0500,
+		},
+		&remote.File{
+			SSH:     t.SSH,
+			Path:    t.scriptPath(t.failureName()),
+			Content: t.script(t.onFailure()),
+			User:    "root",
+			Group:   "root",
+			Perm:    0500,
+		},
+		world.NewConfiguraionBuilder().WithApplier(unit),
+	}, nil
+}
+
+func (t *Timer) Applier() (world.Applier, error) {
+	return nil, nil
+}
+
+func (t *Timer) unit() (*remote.Unit, error) {
+	units, err := t.Units()
+	if err != nil {
+		return nil, err
+	}
+	applier := &remote.Unit{
+		SSH:    t.SSH,
+		Name:   remote.ServiceName(units[0].Name),
+		Enable: true,
+		Start:  true,
+	}
+	for _, unit := range units {
+		applier.Files = append(applier.Files, remote.UnitFile{Path: unit, Content: unit})
+	}
+	return applier, nil
+}
+
+func (t *Timer) Validate(ctx context.Context) error {
+	if t.Expression == nil {
+		return errors.Errorf("expression missing")
+	}
+	return validation.Validate(
+		ctx,
+		t.SSH,
+		t.Name,
+		t.Schedule,
+		t.User,
+	)
+}
+
+// Units returns the timer followed by the services it triggers.
+func (t *Timer) Units() ([]*systemd.Unit, error) {
+	onCalendar, err := t.Schedule.OnCalendar()
+	if err != nil {
+		return nil, errors.Wrap(err, "convert schedule failed")
+	}
+	description := t.Description
+	if description == "" {
+		description = t.Name.String()
+	}
+	return []*systemd.Unit{
+		{
+			Name: fmt.Sprintf("%s.timer", t.Name),
+			Unit: systemd.UnitSection{
+				Description: description,
+			},
+			Timer: &systemd.TimerSection{
+				OnCalendar:         onCalendar,
+				RandomizedDelaySec: t.RandomizedDelay,
+				Persistent:         true,
+			},
+			Install: &systemd.InstallSection{
+				WantedBy: []string{"timers.target"},
+			},
+		},
+		{
+			Name: fmt.Sprintf("%s.service", t.Name),
+			Unit: systemd.UnitSection{
+				Description: description,
+				OnFailure:   []string{fmt.Sprintf("%s.service", t.failureName())},
+			},
+			Service: &systemd.ServiceSection{
+				Type:             "oneshot",
+				User:             t.User.String(),
+				ExecStart:        []string{t.scriptPath(t.Name.String()).String()},
+				StandardOutput:   "journal",
+				StandardError:    "journal",
+				SyslogIdentifier: t.Name.String(),
+			},
+		},
+		{
+			Name: fmt.Sprintf("%s.service", t.failureName()),
+			Unit: systemd.UnitSection{
+				Description: fmt.Sprintf("%s failure notification", description),
+			},
+			Service: &systemd.ServiceSection{
+				Type:             "oneshot",
+				ExecStart:        []string{t.scriptPath(t.failureName()).String()},
+				StandardOutput:   "journal",
+				StandardError:    "journal",
+				SyslogIdentifier: t.Name.String(),
+			},
+		},
+	}, nil
+}
+
+func (t *Timer) failureName() string {
+	return fmt.Sprintf("%s-failure", t.Name)
+}
+
+func (t *Timer) scriptPath(name string) file.Path {
+	return file.Path(fmt.Sprintf("%s/%s.sh", timerScriptDirectory, name))
+}
+
+func (t *Timer) onFailure() content.HasContent {
+	if t.OnFailure != nil {
+		return t.OnFailure
+	}
+	return content.Static(fmt.Sprintf(`logger -p user.err -t %s "%s failed, see journalctl -u %s.service"`, t.Name, t.Name, t.Name))
+}
+
+func (t *Timer) script(expression content.HasContent) content.HasContent {
+	return content.Func(func(ctx context.Context) ([]byte, error) {
+		command, err := expression.Content(ctx)
+		if err != nil {
+			return nil, errors.Wrap(err, "get expression failed")
+		}
+		return []byte(fmt.Sprintf("#!/bin/sh\n%s\n", command)), nil
+	})
+}
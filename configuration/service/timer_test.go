@@ -0,0 +1,49 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package service_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/bborbe/world/configuration/service"
+	"github.com/bborbe/world/pkg/content"
+	"github.com/bborbe/world/pkg/ssh/sshtest"
+	"github.com/bborbe/world/pkg/world"
+)
+
+var _ = Describe("Timer", func() {
+	It("deploys a changed script if the units are satisfied", func() {
+		ctx := context.Background()
+		recorder := &sshtest.Recorder{
+			Responses: []sshtest.Response{
+				{
+					Pattern: `banana\.sh" \| md5sum -c`,
+					Fail:    true,
+				},
+				{
+					Pattern: `is-failed`,
+					Fail:    true,
+				},
+			},
+		}
+		builder := world.Builder{
+			Configuration: &service.Timer{
+				SSH:        recorder,
+				Name:       "banana",
+				Expression: content.Static("echo banana"),
+				Schedule:   "0 3 * * *",
+				User:       "root",
+			},
+		}
+		runner, err := builder.Build(ctx)
+		Expect(err).To(BeNil())
+		Expect(runner.Apply(ctx)).To(BeNil())
+		Expect(recorder.Transcript()).To(ContainSubstring("copy: /usr/local/lib/world/.banana.sh.world-tmp"))
+		Expect(recorder.Transcript()).NotTo(ContainSubstring("copy: /etc/systemd/system/"))
+	})
+})
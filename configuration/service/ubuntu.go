@@ -13,7 +13,7 @@ import (
 )
 
 type Ubuntu struct {
-	SSH *ssh.SSH
+	SSH ssh.Executor
 }
 
 func (u *Ubuntu) Children(ctx context.Context) (world.Configurations, error) {
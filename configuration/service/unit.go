@@ -0,0 +1,77 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package service
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"github.com/bborbe/world/pkg/remote"
+	"github.com/bborbe/world/pkg/ssh"
+	"github.com/bborbe/world/pkg/systemd"
+	"github.com/bborbe/world/pkg/validation"
+	"github.com/bborbe/world/pkg/world"
+)
+
+// Unit installs a typed systemd unit and starts it.
+// Also contains units activated by the unit, like the service of a socket or timer.
+type Unit struct {
+	SSH     ssh.Executor
+	Unit    *systemd.Unit
+	Also    []*systemd.Unit
+	DropIns []*systemd.DropIn
+	// Name of an existing unit if only drop-ins are managed.
+	Name remote.ServiceName
+}
+
+func (u *Unit) Children(ctx context.Context) (world.Configurations, error) {
+	return nil, nil
+}
+
+func (u *Unit) Applier() (world.Applier, error) {
+	applier := &remote.Unit{
+		SSH:   u.SSH,
+		Name:  u.Name,
+		Start: true,
+	}
+	if u.Unit != nil {
+		applier.Name = remote.ServiceName(u.Unit.Name)
+		applier.Enable = u.Unit.Install != nil
+		applier.Files = append(applier.Files, remote.UnitFile{Path: u.Unit, Content: u.Unit})
+	}
+	for _, unit := range u.Also {
+		applier.Files = append(applier.Files, remote.UnitFile{Path: unit, Content: unit})
+	}
+	for _, dropIn := range u.DropIns {
+		applier.Files = append(applier.Files, remote.UnitFile{Path: dropIn, Content: dropIn})
+	}
+	return applier, nil
+}
+
+func (u *Unit) Validate(ctx context.Context) error {
+	if u.Unit == nil && u.Name == "" {
+		return errors.New("Unit or Name required")
+	}
+	if u.Unit != nil {
+		if err := u.Unit.Validate(ctx); err != nil {
+			return err
+		}
+	}
+	for _, unit := range u.Also {
+		if err := unit.Validate(ctx); err != nil {
+			return err
+		}
+	}
+	for _, dropIn := range u.DropIns {
+		if err := dropIn.Validate(ctx); err != nil {
+			return err
+		}
+	}
+	return validation.Validate(
+		ctx,
+		u.SSH,
+	)
+}
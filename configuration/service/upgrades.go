@@ -0,0 +1,52 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package service
+
+import (
+	"context"
+
+	"github.com/bborbe/world/pkg/apt"
+	"github.com/bborbe/world/pkg/remote"
+	"github.com/bborbe/world/pkg/ssh"
+	"github.com/bborbe/world/pkg/validation"
+	"github.com/bborbe/world/pkg/world"
+)
+
+// Upgrades installs all pending package upgrades and reboots the host if required.
+type Upgrades struct {
+	SSH    ssh.Executor
+	Reboot bool
+}
+
+func (u *Upgrades) Children(ctx context.Context) (world.Configurations, error) {
+	result := world.Configurations{
+		world.NewConfiguraionBuilder().WithApplier(&apt.Update{
+			SSH: u.SSH,
+		}),
+		world.NewConfiguraionBuilder().WithApplier(&apt.DistUpgrade{
+			SSH: u.SSH,
+		}),
+		world.NewConfiguraionBuilder().WithApplier(&apt.Autoremove{
+			SSH: u.SSH,
+		}),
+	}
+	if u.Reboot {
+		result = append(result, world.NewConfiguraionBuilder().WithApplier(&remote.Reboot{
+			SSH: u.SSH,
+		}))
+	}
+	return result, nil
+}
+
+func (u *Upgrades) Applier() (world.Applier, error) {
+	return nil, nil
+}
+
+func (u *Upgrades) Validate(ctx context.Context) error {
+	return validation.Validate(
+		ctx,
+		u.SSH,
+	)
+}
@@ -0,0 +1,171 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package service
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+
+	"github.com/bborbe/world/pkg/content"
+	"github.com/bborbe/world/pkg/deployer"
+	"github.com/bborbe/world/pkg/file"
+	"github.com/bborbe/world/pkg/remote"
+	"github.com/bborbe/world/pkg/ssh"
+	"github.com/bborbe/world/pkg/validation"
+	"github.com/bborbe/world/pkg/world"
+)
+
+type Group struct {
+	Name file.Group
+	GID  int
+}
+
+type User struct {
+	Name   file.User
+	UID    int
+	Group  file.Group
+	Groups []file.Group
+	Shell  string
+	// AuthorizedKeys from local files or Teamvault
+	AuthorizedKeys []deployer.SecretValue
+}
+
+func (u User) home() string {
+	return fmt.Sprintf("/home/%s", u.Name)
+}
+
+func (u User) group() file.Group {
+	if u.Group == "" {
+		return file.Group(u.Name)
+	}
+	return u.Group
+}
+
+func (u User) authorizedKeys() content.HasContent {
+	return content.Func(func(ctx context.Context) ([]byte, error) {
+		buf := &bytes.Buffer{}
+		for _, key := range u.AuthorizedKeys {
+			value, err := key.Value(ctx)
+			if err != nil {
+				return nil, errors.Wrapf(err, "get authorized key of %s failed", u.Name)
+			}
+			buf.Write(bytes.TrimSpace(value))
+			buf.WriteString("\n")
+		}
+		return buf.Bytes(), nil
+	})
+}
+
+func (u User) Validate(ctx context.Context) error {
+	for _, key := range u.AuthorizedKeys {
+		if err := key.Validate(ctx); err != nil {
+			return errors.Wrapf(err, "authorized key of %s invalid", u.Name)
+		}
+	}
+	return validation.Validate(
+		ctx,
+		u.Name,
+	)
+}
+
+// Users manages groups, users and their authorized keys.
+// With RemoveUnlisted all regular users not listed are deleted, except the connecting user.
+type Users struct {
+	SSH            ssh.Executor
+	Groups         []Group
+	Users          []User
+	RemoveUnlisted bool
+}
+
+func (u *Users) Children(ctx context.Context) (world.Configurations, error) {
+	var result world.Configurations
+	for _, group := range u.Groups {
+		result = append(result, world.NewConfiguraionBuilder().WithApplier(&remote.Group{
+			SSH:  u.SSH,
+			Name: group.Name,
+			GID:  group.GID,
+		}))
+	}
+	for _, user := range u.Users {
+		result = append(result, u.user(user)...)
+	}
+	if u.RemoveUnlisted {
+		names := make([]file.User, 0, len(u.Users))
+		for _, user := range u.Users {
+			names = append(names, user.Name)
+		}
+		result = append(result, world.NewConfiguraionBuilder().WithApplier(&remote.UnlistedUsersAbsent{
+			SSH:   u.SSH,
+			Users: names,
+		}))
+	}
+	return result, nil
+}
+
+func (u *Users) user(user User) world.Configurations {
+	result := world.Configurations{
+		world.NewConfiguraionBuilder().WithApplier(&remote.User{
+			SSH:    u.SSH,
+			Name:   user.Name,
+			UID:    user.UID,
+			Group:  user.Group,
+			Groups: user.Groups,
+			Shell:  user.Shell,
+			Home:   user.home(),
+		}),
+	}
+	if len(user.AuthorizedKeys) == 0 {
+		return result
+	}
+	sshDirectory := file.Path(fmt.Sprintf("%s/.ssh", user.home()))
+	return append(
+		result,
+		world.NewConfiguraionBuilder().WithApplier(&remote.Directory{
+			SSH:  u.SSH,
+			Path: sshDirectory,
+		}),
+		world.NewConfiguraionBuilder().WithApplier(&remote.Chown{
+			SSH:   u.SSH,
+			Path:  sshDirectory,
+			User:  user.Name,
+			Group: user.group(),
+		}),
+		world.NewConfiguraionBuilder().WithApplier(&remote.Chmod{
+			SSH:  u.SSH,
+			Path: sshDirectory,
+			Perm: 0700,
+		}),
+		&remote.File{
+			SSH:     u.SSH,
+			Path:    file.Path(fmt.Sprintf("%s/authorized_keys", sshDirectory)),
+			Content: user.authorizedKeys(),
+			User:    user.Name,
+			Group:   user.group(),
+			Perm:    0600,
+		},
+	)
+}
+
+func (u *Users) Applier() (world.Applier, error) {
+	return nil, nil
+}
+
+func (u *Users) Validate(ctx context.Context) error {
+	if u.RemoveUnlisted && len(u.Users) == 0 {
+		return errors.New("RemoveUnlisted requires Users")
+	}
+	for _, user := range u.Users {
+		if err := user.Validate(ctx); err != nil {
+			return err
+		}
+	}
+	return validation.Validate(
+		ctx,
+		u.SSH,
+	)
+}
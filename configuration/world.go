@@ -7,6 +7,7 @@ package configuration
 import (
 	"context"
 	"fmt"
+	"sort"
 
 	"github.com/bborbe/world/configuration/service"
 	"github.com/bborbe/world/pkg/dns"
@@ -59,8 +60,8 @@ func (w *World) Validate(ctx context.Context) error {
 
 func (w *World) configurations() map[ClusterName]map[AppName]world.Configuration {
 	return map[ClusterName]map[AppName]world.Configuration{
-		"hetzner-1": w.hetzner1(),
-		"rasp4":     w.rasp4(),
+		vpnServerCluster: w.hetzner1(),
+		"rasp4":          w.rasp4(),
 		// vpn client nodes
 		"nuke":            w.vpnClientNode(Nuke),
 		"fire":            w.vpnClientNode(Fire),
@@ -74,22 +75,73 @@ func (w *World) configurations() map[ClusterName]map[AppName]world.Configuration
 	}
 }
 
-func (w *World) hetzner1() map[AppName]world.Configuration {
-	apiKey := w.TeamvaultSecrets.Password("kLolmq")
-	ip := &hetzner.IP{
+// Upgrades returns a configuration that upgrades all hosts one by one.
+// The vpn server is upgraded last, so the clients are reachable until the end.
+func (w *World) Upgrades() world.Configuration {
+	hosts := w.hosts()
+	var clusterNames []ClusterName
+	for clusterName := range hosts {
+		if clusterName != w.Cluster && w.Cluster != "" {
+			continue
+		}
+		clusterNames = append(clusterNames, clusterName)
+	}
+	sort.Slice(clusterNames, func(i, j int) bool {
+		if (clusterNames[i] == vpnServerCluster) != (clusterNames[j] == vpnServerCluster) {
+			return clusterNames[j] == vpnServerCluster
+		}
+		return clusterNames[i] < clusterNames[j]
+	})
+	var result world.Configurations
+	for _, clusterName := range clusterNames {
+		result = append(result, &service.Upgrades{
+			SSH:    hosts[clusterName],
+			Reboot: true,
+		})
+	}
+	return world.NewConfiguraionBuilder().WithChildren(result)
+}
+
+const vpnServerCluster ClusterName = "hetzner-1"
+
+func (w *World) hosts() map[ClusterName]ssh.Executor {
+	return map[ClusterName]ssh.Executor{
+		vpnServerCluster:  w.hostSSH(w.hetzner1IP()),
+		"rasp4":           w.hostSSH(Rasp4.IP),
+		"nuke":            w.hostSSH(Nuke.IP),
+		"fire":            w.hostSSH(Fire.IP),
+		"fire-k3s-master": w.hostSSH(FireK3sMaster.IP),
+		"fire-k3s-prod":   w.hostSSH(FireK3sProd.IP),
+		"fire-k3s-dev":    w.hostSSH(FireK3sDev.IP),
+		"hell":            w.hostSSH(Hell.IP),
+		"rasp3":           w.hostSSH(Rasp3.IP),
+		"co2hz":           w.hostSSH(Co2hz.IP),
+		"co2wz":           w.hostSSH(Co2wz.IP),
+	}
+}
+
+func (w *World) hetzner1IP() network.IP {
+	return &hetzner.IP{
 		Client: w.HetznerClient,
-		ApiKey: apiKey,
+		ApiKey: w.TeamvaultSecrets.Password("kLolmq"),
 		Name:   "hetzner-1",
 	}
-	user := ssh.User("bborbe")
-	ssh := &ssh.SSH{
+}
+
+func (w *World) hostSSH(ip network.IP) *ssh.SSH {
+	return &ssh.SSH{
 		Host: ssh.Host{
 			IP:   ip,
 			Port: 22,
 		},
-		User:           user,
+		User:           "bborbe",
 		PrivateKeyPath: "/Users/bborbe/.ssh/id_ed25519_personal",
 	}
+}
+
+func (w *World) hetzner1() map[AppName]world.Configuration {
+	ip := w.hetzner1IP()
+	ssh := w.hostSSH(ip)
 	openvpnClients := []Server{
 		Rasp3,
 		Rasp4,
@@ -172,14 +224,7 @@ func (w *World) hetzner1() map[AppName]world.Configuration {
 }
 
 func (w *World) vpnClientNode(server Server) map[AppName]world.Configuration {
-	ssh := &ssh.SSH{
-		Host: ssh.Host{
-			IP:   server.IP,
-			Port: 22,
-		},
-		User:           "bborbe",
-		PrivateKeyPath: "/Users/bborbe/.ssh/id_ed25519_personal",
-	}
+	ssh := w.hostSSH(server.IP)
 	return map[AppName]world.Configuration{
 		"ntpdate": &service.NtpDate{
 			SSH: ssh,
@@ -198,15 +243,7 @@ func (w *World) vpnClientNode(server Server) map[AppName]world.Configuration {
 
 func (w *World) rasp4() map[AppName]world.Configuration {
 	rasp4 := Rasp4
-	ip := rasp4.IP
-	ssh := &ssh.SSH{
-		Host: ssh.Host{
-			IP:   ip,
-			Port: 22,
-		},
-		User:           "bborbe",
-		PrivateKeyPath: "/Users/bborbe/.ssh/id_ed25519_personal",
-	}
+	ssh := w.hostSSH(rasp4.IP)
 	return map[AppName]world.Configuration{
 		"fritzbox-restart": &service.FritzBoxRestart{
 			SSH:              ssh,
@@ -11,6 +11,7 @@ import (
 	"os"
 	"os/signal"
 	"runtime"
+	"sort"
 	"syscall"
 	"time"
 
@@ -22,11 +23,13 @@ import (
 	"github.com/spf13/pflag"
 
 	"github.com/bborbe/world/configuration"
+	"github.com/bborbe/world/configuration/service"
 	"github.com/bborbe/world/pkg/dns"
 	"github.com/bborbe/world/pkg/hetzner"
 	"github.com/bborbe/world/pkg/k8s"
 	"github.com/bborbe/world/pkg/network"
 	"github.com/bborbe/world/pkg/secret"
+	"github.com/bborbe/world/pkg/ssh"
 	"github.com/bborbe/world/pkg/world"
 )
 
@@ -41,7 +44,9 @@ func main() {
 	ctx, cancel := createContext()
 	defer cancel()
 
-	if err := createRootCommand(ctx).Execute(); err != nil {
+	err := createRootCommand(ctx).Execute()
+	ssh.DefaultPool.Close()
+	if err != nil {
 		if glog.V(4) {
 			fmt.Fprintf(os.Stderr, "%+v", err)
 		} else {
@@ -59,8 +64,25 @@ func createRootCommand(ctx context.Context) *cobra.Command {
 	}
 	rootCmd.PersistentFlags().StringP("app", "a", "", "app name")
 	rootCmd.PersistentFlags().StringP("cluster", "c", "", "cluster name")
+	rootCmd.PersistentFlags().String("k8s-transport", k8s.TransportAuto.String(), "transport to kubernetes (auto, native, kubectl)")
+	rootCmd.PersistentPreRunE = func(cmd *cobra.Command, args []string) error {
+		transport, err := cmd.Flags().GetString("k8s-transport")
+		if err != nil {
+			return errors.Wrap(ctx, err, "get parameter k8s-transport failed")
+		}
+		switch k8s.Transport(transport) {
+		case k8s.TransportAuto, k8s.TransportNative, k8s.TransportKubectl:
+			k8s.DefaultTransport = k8s.Transport(transport)
+			return nil
+		default:
+			return errors.Errorf(ctx, "unknown k8s-transport '%s'", transport)
+		}
+	}
 	rootCmd.AddCommand(createApplyCommand(ctx))
+	rootCmd.AddCommand(createUpgradeCommand(ctx))
 	rootCmd.AddCommand(createValidateCommand(ctx))
+	rootCmd.AddCommand(createRenderCommand(ctx))
+	rootCmd.AddCommand(createCronCommand(ctx))
 	rootCmd.AddCommand(createYamlToStructCommand(ctx))
 	rootCmd.AddCommand(createSetDnsCommand(ctx))
 	return rootCmd
@@ -117,6 +139,35 @@ func createApplyCommand(ctx context.Context) *cobra.Command {
 	}
 }
 
+func createUpgradeCommand(ctx context.Context) *cobra.Command {
+	return &cobra.Command{
+		Use:   "upgrade",
+		Short: "Upgrade packages and reboot the hosts one by one",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			flag.Parse()
+			worldConfiguration, err := createWorld(ctx, cmd)
+			if err != nil {
+				return errors.Wrap(ctx, err, "create world failed")
+			}
+			builder := world.Builder{
+				Configuration: worldConfiguration.Upgrades(),
+			}
+			runner, err := builder.Build(ctx)
+			if err != nil {
+				return errors.Wrap(ctx, err, "create runner failed")
+			}
+			if err := runner.Validate(ctx); err != nil {
+				return errors.Wrap(ctx, err, "validate failed")
+			}
+			if err := runner.Apply(ctx); err != nil {
+				return errors.Wrap(ctx, err, "upgrade failed")
+			}
+			glog.V(4).Infof("upgrade finished")
+			return nil
+		},
+	}
+}
+
 func createValidateCommand(ctx context.Context) *cobra.Command {
 	return &cobra.Command{
 		Use:   "validate",
@@ -134,6 +185,103 @@ func createValidateCommand(ctx context.Context) *cobra.Command {
 	}
 }
 
+func createRenderCommand(ctx context.Context) *cobra.Command {
+	command := &cobra.Command{
+		Use:   "render",
+		Short: "Render the Kubernetes objects of the world to yaml files",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			out, err := cmd.Flags().GetString("out")
+			if err != nil {
+				return errors.Wrap(ctx, err, "get parameter out failed")
+			}
+			if out == "" {
+				return errors.New(ctx, "parameter out missing")
+			}
+			includeSecrets, err := cmd.Flags().GetBool("include-secrets")
+			if err != nil {
+				return errors.Wrap(ctx, err, "get parameter include-secrets failed")
+			}
+			runner, err := createRunner(ctx, cmd)
+			if err != nil {
+				return errors.Wrap(ctx, err, "create runner failed")
+			}
+			if err := runner.Validate(ctx); err != nil {
+				return errors.Wrap(ctx, err, "validate failed")
+			}
+			renderCtx := k8s.WithRender(ctx, &k8s.Render{
+				Dir:            out,
+				IncludeSecrets: includeSecrets,
+			})
+			if err := runner.ApplyFiltered(renderCtx, k8s.IsKubernetesApplier); err != nil {
+				return errors.Wrap(ctx, err, "render failed")
+			}
+			glog.V(4).Infof("render finished")
+			return nil
+		},
+	}
+	command.Flags().StringP("out", "o", "", "output directory, objects are written to <out>/<context>/<namespace>/<kind>-<name>.yaml, existing files of the context are removed")
+	command.Flags().Bool("include-secrets", false, "write Secrets with their values, skipped by default")
+	return command
+}
+
+func createCronCommand(ctx context.Context) *cobra.Command {
+	command := &cobra.Command{
+		Use:   "cron",
+		Short: "Inspect cron jobs and timers of the world",
+	}
+	command.AddCommand(createCronNextCommand(ctx))
+	return command
+}
+
+func createCronNextCommand(ctx context.Context) *cobra.Command {
+	command := &cobra.Command{
+		Use:   "next",
+		Short: "List the next fire times of all cron jobs and timers",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			count, err := cmd.Flags().GetInt("count")
+			if err != nil {
+				return errors.Wrap(ctx, err, "get parameter count failed")
+			}
+			worldConfiguration, err := createWorld(ctx, cmd)
+			if err != nil {
+				return errors.Wrap(ctx, err, "create world failed")
+			}
+			schedules := map[string]service.CronSchedule{}
+			err = world.Walk(ctx, worldConfiguration, func(configuration world.Configuration) error {
+				switch c := configuration.(type) {
+				case *service.Cron:
+					schedules[c.Name.String()] = c.Schedule
+				case *service.Timer:
+					schedules[c.Name.String()] = c.Schedule
+				}
+				return nil
+			})
+			if err != nil {
+				return errors.Wrap(ctx, err, "collect schedules failed")
+			}
+			names := make([]string, 0, len(schedules))
+			for name := range schedules {
+				names = append(names, name)
+			}
+			sort.Strings(names)
+			now := time.Now()
+			for _, name := range names {
+				parsed, err := schedules[name].Parse()
+				if err != nil {
+					return errors.Wrapf(ctx, err, "parse schedule of %s failed", name)
+				}
+				fmt.Printf("%s (%s)\n", name, schedules[name])
+				for _, next := range parsed.NextN(now, count) {
+					fmt.Printf("  %s\n", next.Format("2006-01-02 15:04 MST"))
+				}
+			}
+			return nil
+		},
+	}
+	command.Flags().IntP("count", "n", 5, "number of fire times")
+	return command
+}
+
 func createSetDnsCommand(ctx context.Context) *cobra.Command {
 	command := &cobra.Command{
 		Use:   "set-dns",
@@ -193,6 +341,17 @@ func createYamlToStructCommand(ctx context.Context) *cobra.Command {
 }
 
 func createRunner(ctx context.Context, cmd *cobra.Command) (*world.Runner, error) {
+	worldConfiguration, err := createWorld(ctx, cmd)
+	if err != nil {
+		return nil, err
+	}
+	builder := world.Builder{
+		Configuration: worldConfiguration,
+	}
+	return builder.Build(ctx)
+}
+
+func createWorld(ctx context.Context, cmd *cobra.Command) (*configuration.World, error) {
 	teamvaultConfigPath := teamvault.TeamvaultConfigPath("~/.teamvault.json")
 	teamvaultConfigPath, err := teamvaultConfigPath.NormalizePath()
 	if err != nil {
@@ -218,24 +377,21 @@ func createRunner(ctx context.Context, cmd *cobra.Command) (*world.Runner, error
 		return nil, errors.Wrapf(ctx, err, "create httpClient failed")
 	}
 
-	builder := world.Builder{
-		Configuration: &configuration.World{
-			HetznerClient: hetzner.NewClient(),
-			App:           configuration.AppName(appName),
-			Cluster:       configuration.ClusterName(clusterName),
-			TeamvaultSecrets: &secret.Teamvault{
-				TeamvaultConnector: teamvault.NewCacheConnector(
-					teamvault.NewDiskFallbackConnector(
-						teamvault.NewRemoteConnector(
-							httpClient,
-							teamvaultConfig.Url,
-							teamvaultConfig.User,
-							teamvaultConfig.Password,
-						),
+	return &configuration.World{
+		HetznerClient: hetzner.NewClient(),
+		App:           configuration.AppName(appName),
+		Cluster:       configuration.ClusterName(clusterName),
+		TeamvaultSecrets: &secret.Teamvault{
+			TeamvaultConnector: teamvault.NewCacheConnector(
+				teamvault.NewDiskFallbackConnector(
+					teamvault.NewRemoteConnector(
+						httpClient,
+						teamvaultConfig.Url,
+						teamvaultConfig.User,
+						teamvaultConfig.Password,
 					),
 				),
-			},
+			),
 		},
-	}
-	return builder.Build(ctx)
+	}, nil
 }
@@ -0,0 +1,468 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package mocks
+
+import (
+	"context"
+	"os"
+	"sync"
+
+	"github.com/bborbe/world/pkg/ssh"
+)
+
+type Executor struct {
+	CloseStub        func()
+	closeMutex       sync.RWMutex
+	closeArgsForCall []struct {
+	}
+	CopyFileStub        func(context.Context, string, []byte, os.FileMode) error
+	copyFileMutex       sync.RWMutex
+	copyFileArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 []byte
+		arg4 os.FileMode
+	}
+	copyFileReturns struct {
+		result1 error
+	}
+	copyFileReturnsOnCall map[int]struct {
+		result1 error
+	}
+	RunCommandStub        func(context.Context, string) error
+	runCommandMutex       sync.RWMutex
+	runCommandArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+	}
+	runCommandReturns struct {
+		result1 error
+	}
+	runCommandReturnsOnCall map[int]struct {
+		result1 error
+	}
+	RunCommandStdinStub        func(context.Context, string, []byte) error
+	runCommandStdinMutex       sync.RWMutex
+	runCommandStdinArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 []byte
+	}
+	runCommandStdinReturns struct {
+		result1 error
+	}
+	runCommandStdinReturnsOnCall map[int]struct {
+		result1 error
+	}
+	RunCommandStdoutStub        func(context.Context, string) ([]byte, error)
+	runCommandStdoutMutex       sync.RWMutex
+	runCommandStdoutArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+	}
+	runCommandStdoutReturns struct {
+		result1 []byte
+		result2 error
+	}
+	runCommandStdoutReturnsOnCall map[int]struct {
+		result1 []byte
+		result2 error
+	}
+	ValidateStub        func(context.Context) error
+	validateMutex       sync.RWMutex
+	validateArgsForCall []struct {
+		arg1 context.Context
+	}
+	validateReturns struct {
+		result1 error
+	}
+	validateReturnsOnCall map[int]struct {
+		result1 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *Executor) Close() {
+	fake.closeMutex.Lock()
+	fake.closeArgsForCall = append(fake.closeArgsForCall, struct {
+	}{})
+	stub := fake.CloseStub
+	fake.recordInvocation("Close", []interface{}{})
+	fake.closeMutex.Unlock()
+	if stub != nil {
+		fake.CloseStub()
+	}
+}
+
+func (fake *Executor) CloseCallCount() int {
+	fake.closeMutex.RLock()
+	defer fake.closeMutex.RUnlock()
+	return len(fake.closeArgsForCall)
+}
+
+func (fake *Executor) CloseCalls(stub func()) {
+	fake.closeMutex.Lock()
+	defer fake.closeMutex.Unlock()
+	fake.CloseStub = stub
+}
+
+func (fake *Executor) CopyFile(arg1 context.Context, arg2 string, arg3 []byte, arg4 os.FileMode) error {
+	var arg3Copy []byte
+	if arg3 != nil {
+		arg3Copy = make([]byte, len(arg3))
+		copy(arg3Copy, arg3)
+	}
+	fake.copyFileMutex.Lock()
+	ret, specificReturn := fake.copyFileReturnsOnCall[len(fake.copyFileArgsForCall)]
+	fake.copyFileArgsForCall = append(fake.copyFileArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 []byte
+		arg4 os.FileMode
+	}{arg1, arg2, arg3Copy, arg4})
+	stub := fake.CopyFileStub
+	fakeReturns := fake.copyFileReturns
+	fake.recordInvocation("CopyFile", []interface{}{arg1, arg2, arg3Copy, arg4})
+	fake.copyFileMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *Executor) CopyFileCallCount() int {
+	fake.copyFileMutex.RLock()
+	defer fake.copyFileMutex.RUnlock()
+	return len(fake.copyFileArgsForCall)
+}
+
+func (fake *Executor) CopyFileCalls(stub func(context.Context, string, []byte, os.FileMode) error) {
+	fake.copyFileMutex.Lock()
+	defer fake.copyFileMutex.Unlock()
+	fake.CopyFileStub = stub
+}
+
+func (fake *Executor) CopyFileArgsForCall(i int) (context.Context, string, []byte, os.FileMode) {
+	fake.copyFileMutex.RLock()
+	defer fake.copyFileMutex.RUnlock()
+	argsForCall := fake.copyFileArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *Executor) CopyFileReturns(result1 error) {
+	fake.copyFileMutex.Lock()
+	defer fake.copyFileMutex.Unlock()
+	fake.CopyFileStub = nil
+	fake.copyFileReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *Executor) CopyFileReturnsOnCall(i int, result1 error) {
+	fake.copyFileMutex.Lock()
+	defer fake.copyFileMutex.Unlock()
+	fake.CopyFileStub = nil
+	if fake.copyFileReturnsOnCall == nil {
+		fake.copyFileReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.copyFileReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *Executor) RunCommand(arg1 context.Context, arg2 string) error {
+	fake.runCommandMutex.Lock()
+	ret, specificReturn := fake.runCommandReturnsOnCall[len(fake.runCommandArgsForCall)]
+	fake.runCommandArgsForCall = append(fake.runCommandArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.RunCommandStub
+	fakeReturns := fake.runCommandReturns
+	fake.recordInvocation("RunCommand", []interface{}{arg1, arg2})
+	fake.runCommandMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *Executor) RunCommandCallCount() int {
+	fake.runCommandMutex.RLock()
+	defer fake.runCommandMutex.RUnlock()
+	return len(fake.runCommandArgsForCall)
+}
+
+func (fake *Executor) RunCommandCalls(stub func(context.Context, string) error) {
+	fake.runCommandMutex.Lock()
+	defer fake.runCommandMutex.Unlock()
+	fake.RunCommandStub = stub
+}
+
+func (fake *Executor) RunCommandArgsForCall(i int) (context.Context, string) {
+	fake.runCommandMutex.RLock()
+	defer fake.runCommandMutex.RUnlock()
+	argsForCall := fake.runCommandArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *Executor) RunCommandReturns(result1 error) {
+	fake.runCommandMutex.Lock()
+	defer fake.runCommandMutex.Unlock()
+	fake.RunCommandStub = nil
+	fake.runCommandReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *Executor) RunCommandReturnsOnCall(i int, result1 error) {
+	fake.runCommandMutex.Lock()
+	defer fake.runCommandMutex.Unlock()
+	fake.RunCommandStub = nil
+	if fake.runCommandReturnsOnCall == nil {
+		fake.runCommandReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.runCommandReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *Executor) RunCommandStdin(arg1 context.Context, arg2 string, arg3 []byte) error {
+	var arg3Copy []byte
+	if arg3 != nil {
+		arg3Copy = make([]byte, len(arg3))
+		copy(arg3Copy, arg3)
+	}
+	fake.runCommandStdinMutex.Lock()
+	ret, specificReturn := fake.runCommandStdinReturnsOnCall[len(fake.runCommandStdinArgsForCall)]
+	fake.runCommandStdinArgsForCall = append(fake.runCommandStdinArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 []byte
+	}{arg1, arg2, arg3Copy})
+	stub := fake.RunCommandStdinStub
+	fakeReturns := fake.runCommandStdinReturns
+	fake.recordInvocation("RunCommandStdin", []interface{}{arg1, arg2, arg3Copy})
+	fake.runCommandStdinMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *Executor) RunCommandStdinCallCount() int {
+	fake.runCommandStdinMutex.RLock()
+	defer fake.runCommandStdinMutex.RUnlock()
+	return len(fake.runCommandStdinArgsForCall)
+}
+
+func (fake *Executor) RunCommandStdinCalls(stub func(context.Context, string, []byte) error) {
+	fake.runCommandStdinMutex.Lock()
+	defer fake.runCommandStdinMutex.Unlock()
+	fake.RunCommandStdinStub = stub
+}
+
+func (fake *Executor) RunCommandStdinArgsForCall(i int) (context.Context, string, []byte) {
+	fake.runCommandStdinMutex.RLock()
+	defer fake.runCommandStdinMutex.RUnlock()
+	argsForCall := fake.runCommandStdinArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *Executor) RunCommandStdinReturns(result1 error) {
+	fake.runCommandStdinMutex.Lock()
+	defer fake.runCommandStdinMutex.Unlock()
+	fake.RunCommandStdinStub = nil
+	fake.runCommandStdinReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *Executor) RunCommandStdinReturnsOnCall(i int, result1 error) {
+	fake.runCommandStdinMutex.Lock()
+	defer fake.runCommandStdinMutex.Unlock()
+	fake.RunCommandStdinStub = nil
+	if fake.runCommandStdinReturnsOnCall == nil {
+		fake.runCommandStdinReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.runCommandStdinReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *Executor) RunCommandStdout(arg1 context.Context, arg2 string) ([]byte, error) {
+	fake.runCommandStdoutMutex.Lock()
+	ret, specificReturn := fake.runCommandStdoutReturnsOnCall[len(fake.runCommandStdoutArgsForCall)]
+	fake.runCommandStdoutArgsForCall = append(fake.runCommandStdoutArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.RunCommandStdoutStub
+	fakeReturns := fake.runCommandStdoutReturns
+	fake.recordInvocation("RunCommandStdout", []interface{}{arg1, arg2})
+	fake.runCommandStdoutMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *Executor) RunCommandStdoutCallCount() int {
+	fake.runCommandStdoutMutex.RLock()
+	defer fake.runCommandStdoutMutex.RUnlock()
+	return len(fake.runCommandStdoutArgsForCall)
+}
+
+func (fake *Executor) RunCommandStdoutCalls(stub func(context.Context, string) ([]byte, error)) {
+	fake.runCommandStdoutMutex.Lock()
+	defer fake.runCommandStdoutMutex.Unlock()
+	fake.RunCommandStdoutStub = stub
+}
+
+func (fake *Executor) RunCommandStdoutArgsForCall(i int) (context.Context, string) {
+	fake.runCommandStdoutMutex.RLock()
+	defer fake.runCommandStdoutMutex.RUnlock()
+	argsForCall := fake.runCommandStdoutArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *Executor) RunCommandStdoutReturns(result1 []byte, result2 error) {
+	fake.runCommandStdoutMutex.Lock()
+	defer fake.runCommandStdoutMutex.Unlock()
+	fake.RunCommandStdoutStub = nil
+	fake.runCommandStdoutReturns = struct {
+		result1 []byte
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *Executor) RunCommandStdoutReturnsOnCall(i int, result1 []byte, result2 error) {
+	fake.runCommandStdoutMutex.Lock()
+	defer fake.runCommandStdoutMutex.Unlock()
+	fake.RunCommandStdoutStub = nil
+	if fake.runCommandStdoutReturnsOnCall == nil {
+		fake.runCommandStdoutReturnsOnCall = make(map[int]struct {
+			result1 []byte
+			result2 error
+		})
+	}
+	fake.runCommandStdoutReturnsOnCall[i] = struct {
+		result1 []byte
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *Executor) Validate(arg1 context.Context) error {
+	fake.validateMutex.Lock()
+	ret, specificReturn := fake.validateReturnsOnCall[len(fake.validateArgsForCall)]
+	fake.validateArgsForCall = append(fake.validateArgsForCall, struct {
+		arg1 context.Context
+	}{arg1})
+	stub := fake.ValidateStub
+	fakeReturns := fake.validateReturns
+	fake.recordInvocation("Validate", []interface{}{arg1})
+	fake.validateMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *Executor) ValidateCallCount() int {
+	fake.validateMutex.RLock()
+	defer fake.validateMutex.RUnlock()
+	return len(fake.validateArgsForCall)
+}
+
+func (fake *Executor) ValidateCalls(stub func(context.Context) error) {
+	fake.validateMutex.Lock()
+	defer fake.validateMutex.Unlock()
+	fake.ValidateStub = stub
+}
+
+func (fake *Executor) ValidateArgsForCall(i int) context.Context {
+	fake.validateMutex.RLock()
+	defer fake.validateMutex.RUnlock()
+	argsForCall := fake.validateArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *Executor) ValidateReturns(result1 error) {
+	fake.validateMutex.Lock()
+	defer fake.validateMutex.Unlock()
+	fake.ValidateStub = nil
+	fake.validateReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *Executor) ValidateReturnsOnCall(i int, result1 error) {
+	fake.validateMutex.Lock()
+	defer fake.validateMutex.Unlock()
+	fake.ValidateStub = nil
+	if fake.validateReturnsOnCall == nil {
+		fake.validateReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.validateReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *Executor) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.closeMutex.RLock()
+	defer fake.closeMutex.RUnlock()
+	fake.copyFileMutex.RLock()
+	defer fake.copyFileMutex.RUnlock()
+	fake.runCommandMutex.RLock()
+	defer fake.runCommandMutex.RUnlock()
+	fake.runCommandStdinMutex.RLock()
+	defer fake.runCommandStdinMutex.RUnlock()
+	fake.runCommandStdoutMutex.RLock()
+	defer fake.runCommandStdoutMutex.RUnlock()
+	fake.validateMutex.RLock()
+	defer fake.validateMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *Executor) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ ssh.Executor = new(Executor)
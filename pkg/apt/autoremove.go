@@ -12,7 +12,7 @@ import (
 )
 
 type Autoremove struct {
-	SSH *ssh.SSH
+	SSH ssh.Executor
 }
 
 func (a *Autoremove) Satisfied(ctx context.Context) (bool, error) {
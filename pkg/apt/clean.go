@@ -12,7 +12,7 @@ import (
 )
 
 type Clean struct {
-	SSH *ssh.SSH
+	SSH ssh.Executor
 }
 
 func (c *Clean) Satisfied(ctx context.Context) (bool, error) {
@@ -0,0 +1,44 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package apt
+
+import (
+	"context"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/bborbe/world/pkg/ssh"
+	"github.com/bborbe/world/pkg/validation"
+)
+
+type DistUpgrade struct {
+	SSH ssh.Executor
+}
+
+// Satisfied if the simulation finds nothing to install. A failing simulation is returned as error.
+func (d *DistUpgrade) Satisfied(ctx context.Context) (bool, error) {
+	stdout, err := d.SSH.RunCommandStdout(ctx, `DEBIAN_FRONTEND=noninteractive apt-get dist-upgrade --simulate --quiet`)
+	if err != nil {
+		return false, errors.Wrap(err, "simulate dist-upgrade failed")
+	}
+	for _, line := range strings.Split(string(stdout), "\n") {
+		if strings.HasPrefix(line, "Inst ") {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+func (d *DistUpgrade) Apply(ctx context.Context) error {
+	return d.SSH.RunCommand(ctx, `DEBIAN_FRONTEND=noninteractive apt-get dist-upgrade --quiet --yes -o Dpkg::Options::="--force-confdef" -o Dpkg::Options::="--force-confold"`)
+}
+
+func (d *DistUpgrade) Validate(ctx context.Context) error {
+	return validation.Validate(
+		ctx,
+		d.SSH,
+	)
+}
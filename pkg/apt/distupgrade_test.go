@@ -0,0 +1,47 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package apt_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/bborbe/world/pkg/apt"
+	"github.com/bborbe/world/pkg/ssh/sshtest"
+)
+
+var _ = Describe("DistUpgrade", func() {
+	var ctx context.Context
+	var recorder *sshtest.Recorder
+	var distUpgrade *apt.DistUpgrade
+	BeforeEach(func() {
+		ctx = context.Background()
+		recorder = &sshtest.Recorder{}
+		distUpgrade = &apt.DistUpgrade{
+			SSH: recorder,
+		}
+	})
+	It("is satisfied without packages to install", func() {
+		recorder.Responses = []sshtest.Response{
+			{Pattern: "--simulate", Stdout: []byte("Reading package lists...\n0 upgraded, 0 newly installed, 0 to remove and 0 not upgraded.\n")},
+		}
+		Expect(distUpgrade.Satisfied(ctx)).To(BeTrue())
+	})
+	It("is not satisfied with packages to install", func() {
+		recorder.Responses = []sshtest.Response{
+			{Pattern: "--simulate", Stdout: []byte("Inst curl [7.81.0-1] (7.81.0-2 Ubuntu:22.04/jammy-updates [amd64])\n")},
+		}
+		Expect(distUpgrade.Satisfied(ctx)).To(BeFalse())
+	})
+	It("returns error if the simulation fails", func() {
+		recorder.Responses = []sshtest.Response{
+			{Pattern: "--simulate", Fail: true},
+		}
+		_, err := distUpgrade.Satisfied(ctx)
+		Expect(err).NotTo(BeNil())
+	})
+})
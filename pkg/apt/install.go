@@ -7,6 +7,7 @@ package apt
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"github.com/pkg/errors"
 
@@ -17,16 +18,50 @@ import (
 type Package string
 
 type Install struct {
-	SSH     *ssh.SSH
+	SSH     ssh.Executor
 	Package string
+	// Version pins the package to the given version.
+	Version string
+	// Hold prevents the package from being upgraded.
+	Hold bool
 }
 
 func (i *Install) Satisfied(ctx context.Context) (bool, error) {
-	return false, nil
+	status, err := PackageStatus(ctx, i.SSH, i.Package)
+	if err != nil {
+		return false, err
+	}
+	if !status.Installed() {
+		return false, nil
+	}
+	if i.Version != "" && status.Version != i.Version {
+		return false, nil
+	}
+	if i.Hold && !status.Held() {
+		return false, nil
+	}
+	return true, nil
 }
 
 func (i *Install) Apply(ctx context.Context) error {
-	return i.SSH.RunCommand(ctx, fmt.Sprintf("DEBIAN_FRONTEND=noninteractive apt-get install --quiet --yes --no-install-recommends %s", i.Package))
+	pkg := i.Package
+	options := "--quiet --yes --no-install-recommends"
+	if i.Version != "" {
+		pkg = fmt.Sprintf("%s=%s", i.Package, i.Version)
+		options += " --allow-downgrades"
+	}
+	if i.Hold {
+		options += " --allow-change-held-packages"
+	}
+	if err := i.SSH.RunCommand(ctx, fmt.Sprintf("DEBIAN_FRONTEND=noninteractive apt-get install %s %s", options, pkg)); err != nil {
+		return errors.Wrapf(err, "install %s failed", pkg)
+	}
+	if i.Hold {
+		if err := i.SSH.RunCommand(ctx, fmt.Sprintf("apt-mark hold %s", i.Package)); err != nil {
+			return errors.Wrapf(err, "hold %s failed", i.Package)
+		}
+	}
+	return nil
 }
 
 func (i *Install) Validate(ctx context.Context) error {
@@ -38,3 +73,49 @@ func (i *Install) Validate(ctx context.Context) error {
 		i.SSH,
 	)
 }
+
+// Status of a package as reported by dpkg-query.
+type Status struct {
+	Want    string
+	Flag    string
+	State   string
+	Version string
+}
+
+func (s Status) Installed() bool {
+	return s.State == "installed"
+}
+
+func (s Status) Held() bool {
+	return s.Want == "hold"
+}
+
+// Known returns false if the package was never installed or already purged.
+func (s Status) Known() bool {
+	return s.State != "" && s.State != "not-installed"
+}
+
+// PackageStatus returns the dpkg status of the given package.
+// Unknown packages return an empty status.
+func PackageStatus(ctx context.Context, executor ssh.Executor, pkg string) (*Status, error) {
+	stdout, err := executor.RunCommandStdout(ctx, fmt.Sprintf(`dpkg-query --show --showformat="\${Status} \${Version}" %s 2>/dev/null || true`, pkg))
+	if err != nil {
+		return nil, errors.Wrapf(err, "get status of package %s failed", pkg)
+	}
+	return ParseStatus(stdout), nil
+}
+
+// ParseStatus parses the output of dpkg-query like "install ok installed 1.2.3-1".
+func ParseStatus(content []byte) *Status {
+	parts := strings.Fields(string(content))
+	status := &Status{}
+	if len(parts) >= 3 {
+		status.Want = parts[0]
+		status.Flag = parts[1]
+		status.State = parts[2]
+	}
+	if len(parts) >= 4 {
+		status.Version = parts[3]
+	}
+	return status
+}
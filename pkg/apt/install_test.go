@@ -0,0 +1,107 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package apt_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/bborbe/world/pkg/apt"
+	"github.com/bborbe/world/pkg/ssh/sshtest"
+)
+
+var _ = Describe("Install", func() {
+	var ctx context.Context
+	var recorder *sshtest.Recorder
+	var install *apt.Install
+	BeforeEach(func() {
+		ctx = context.Background()
+		recorder = &sshtest.Recorder{}
+		install = &apt.Install{
+			SSH:     recorder,
+			Package: "curl",
+		}
+	})
+	Context("Satisfied", func() {
+		var satisfied bool
+		var err error
+		var status string
+		JustBeforeEach(func() {
+			recorder.Responses = []sshtest.Response{
+				{
+					Pattern: "^dpkg-query",
+					Stdout:  []byte(status),
+				},
+			}
+			satisfied, err = install.Satisfied(ctx)
+		})
+		Context("installed", func() {
+			BeforeEach(func() {
+				status = "install ok installed 7.81.0"
+			})
+			It("returns no error", func() {
+				Expect(err).To(BeNil())
+			})
+			It("is satisfied", func() {
+				Expect(satisfied).To(BeTrue())
+			})
+		})
+		Context("unknown", func() {
+			BeforeEach(func() {
+				status = ""
+			})
+			It("is not satisfied", func() {
+				Expect(satisfied).To(BeFalse())
+			})
+		})
+		Context("removed", func() {
+			BeforeEach(func() {
+				status = "deinstall ok config-files 7.81.0"
+			})
+			It("is not satisfied", func() {
+				Expect(satisfied).To(BeFalse())
+			})
+		})
+		Context("other version pinned", func() {
+			BeforeEach(func() {
+				status = "install ok installed 7.81.0"
+				install.Version = "7.82.0"
+			})
+			It("is not satisfied", func() {
+				Expect(satisfied).To(BeFalse())
+			})
+		})
+		Context("hold required", func() {
+			BeforeEach(func() {
+				status = "install ok installed 7.81.0"
+				install.Hold = true
+			})
+			It("is not satisfied", func() {
+				Expect(satisfied).To(BeFalse())
+			})
+		})
+		Context("held", func() {
+			BeforeEach(func() {
+				status = "hold ok installed 7.81.0"
+				install.Hold = true
+			})
+			It("is satisfied", func() {
+				Expect(satisfied).To(BeTrue())
+			})
+		})
+	})
+	Context("Apply", func() {
+		It("installs pinned version and holds it", func() {
+			install.Version = "7.81.0"
+			install.Hold = true
+			Expect(install.Apply(ctx)).To(BeNil())
+			Expect(recorder.Transcript()).To(Equal(`run: DEBIAN_FRONTEND=noninteractive apt-get install --quiet --yes --no-install-recommends --allow-downgrades --allow-change-held-packages curl=7.81.0
+run: apt-mark hold curl
+`))
+		})
+	})
+})
@@ -0,0 +1,54 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package apt
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+
+	"github.com/bborbe/world/pkg/ssh"
+	"github.com/bborbe/world/pkg/validation"
+)
+
+type Remove struct {
+	SSH     ssh.Executor
+	Package string
+	// Purge removes the configuration files too.
+	Purge bool
+}
+
+func (r *Remove) Satisfied(ctx context.Context) (bool, error) {
+	status, err := PackageStatus(ctx, r.SSH, r.Package)
+	if err != nil {
+		return false, err
+	}
+	if r.Purge {
+		return !status.Known(), nil
+	}
+	return !status.Installed(), nil
+}
+
+func (r *Remove) Apply(ctx context.Context) error {
+	command := "remove"
+	if r.Purge {
+		command = "purge"
+	}
+	return errors.Wrapf(
+		r.SSH.RunCommand(ctx, fmt.Sprintf("DEBIAN_FRONTEND=noninteractive apt-get %s --quiet --yes --allow-change-held-packages %s", command, r.Package)),
+		"%s %s failed", command, r.Package,
+	)
+}
+
+func (r *Remove) Validate(ctx context.Context) error {
+	if r.Package == "" {
+		return errors.New("Package missing")
+	}
+	return validation.Validate(
+		ctx,
+		r.SSH,
+	)
+}
@@ -0,0 +1,112 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package apt
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/bborbe/world/pkg/content"
+	"github.com/bborbe/world/pkg/facts"
+	"github.com/bborbe/world/pkg/file"
+	"github.com/bborbe/world/pkg/remote"
+	"github.com/bborbe/world/pkg/ssh"
+	"github.com/bborbe/world/pkg/validation"
+)
+
+const keyringDirectory = "/etc/apt/keyrings"
+
+// Repository adds an apt source signed by its own keyring.
+type Repository struct {
+	SSH  ssh.Executor
+	Name string
+	// KeyURL of the ascii armored signing key.
+	KeyURL     string
+	URL        string
+	Components []string
+	// Suite of the repository. Default is the codename of the host.
+	Suite string
+	// Architecture of the repository. Default is the architecture of the host.
+	Architecture string
+}
+
+func (r *Repository) Satisfied(ctx context.Context) (bool, error) {
+	if err := r.SSH.RunCommand(ctx, fmt.Sprintf("test -s %s", r.keyringPath())); err != nil {
+		return false, nil
+	}
+	return r.sources().Satisfied(ctx)
+}
+
+func (r *Repository) Apply(ctx context.Context) error {
+	if err := r.SSH.RunCommand(ctx, fmt.Sprintf("install -d -m 0755 %s", keyringDirectory)); err != nil {
+		return errors.Wrap(err, "create keyring directory failed")
+	}
+	if err := r.SSH.RunCommand(ctx, fmt.Sprintf("curl -fsSL %s | gpg --dearmor --yes -o %s && chmod 0644 %s", r.KeyURL, r.keyringPath(), r.keyringPath())); err != nil {
+		return errors.Wrapf(err, "download key of repository %s failed", r.Name)
+	}
+	return errors.Wrapf(r.sources().Apply(ctx), "write sources of repository %s failed", r.Name)
+}
+
+func (r *Repository) Validate(ctx context.Context) error {
+	if r.Name == "" {
+		return errors.New("Name missing")
+	}
+	if r.KeyURL == "" {
+		return errors.New("KeyURL missing")
+	}
+	if r.URL == "" {
+		return errors.New("URL missing")
+	}
+	if len(r.Components) == 0 {
+		return errors.New("Components missing")
+	}
+	return validation.Validate(
+		ctx,
+		r.SSH,
+	)
+}
+
+// Line returns the sources.list entry of the repository.
+func (r *Repository) Line(ctx context.Context) (string, error) {
+	suite := r.Suite
+	architecture := r.Architecture
+	if suite == "" || architecture == "" {
+		f, err := facts.Get(ctx, r.SSH)
+		if err != nil {
+			return "", errors.Wrap(err, "get facts failed")
+		}
+		if suite == "" {
+			suite = f.OS.VersionCodename
+		}
+		if architecture == "" {
+			architecture = f.Architecture
+		}
+	}
+	return fmt.Sprintf("deb [arch=%s signed-by=%s] %s %s %s", architecture, r.keyringPath(), r.URL, suite, strings.Join(r.Components, " ")), nil
+}
+
+func (r *Repository) sources() *remote.FileContent {
+	return &remote.FileContent{
+		SSH:  r.SSH,
+		Path: file.Path(fmt.Sprintf("/etc/apt/sources.list.d/%s.list", r.Name)),
+		Content: content.Func(func(ctx context.Context) ([]byte, error) {
+			line, err := r.Line(ctx)
+			if err != nil {
+				return nil, err
+			}
+			return []byte(line + "\n"), nil
+		}),
+		User:  "root",
+		Group: "root",
+		Perm:  0644,
+	}
+}
+
+func (r *Repository) keyringPath() string {
+	return fmt.Sprintf("%s/%s.gpg", keyringDirectory, r.Name)
+}
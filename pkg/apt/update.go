@@ -6,21 +6,35 @@ package apt
 
 import (
 	"context"
+	"fmt"
+	"time"
 
 	"github.com/bborbe/world/pkg/ssh"
 	"github.com/bborbe/world/pkg/validation"
 )
 
+// updateStamp is touched after each successful update.
+const updateStamp = "/var/lib/apt/world-update-stamp"
+
 type Update struct {
-	SSH *ssh.SSH
+	SSH ssh.Executor
+	// MaxAge of the package cache before update runs again. Default one hour.
+	MaxAge time.Duration
 }
 
+// Satisfied if the last update is younger than MaxAge and no source was changed since.
 func (u *Update) Satisfied(ctx context.Context) (bool, error) {
-	return false, nil
+	return u.SSH.RunCommand(ctx, fmt.Sprintf(
+		`test -f %s && test $(( $(date +%%s) - $(stat -c %%Y %s) )) -lt %d && test -z "$(find /etc/apt/sources.list /etc/apt/sources.list.d -newer %s 2>/dev/null)"`,
+		updateStamp,
+		updateStamp,
+		int(u.maxAge().Seconds()),
+		updateStamp,
+	)) == nil, nil
 }
 
 func (u *Update) Apply(ctx context.Context) error {
-	return u.SSH.RunCommand(ctx, "DEBIAN_FRONTEND=noninteractive apt-get update --quiet")
+	return u.SSH.RunCommand(ctx, fmt.Sprintf("DEBIAN_FRONTEND=noninteractive apt-get update --quiet && touch %s", updateStamp))
 }
 
 func (u *Update) Validate(ctx context.Context) error {
@@ -29,3 +43,10 @@ func (u *Update) Validate(ctx context.Context) error {
 		u.SSH,
 	)
 }
+
+func (u *Update) maxAge() time.Duration {
+	if u.MaxAge <= 0 {
+		return time.Hour
+	}
+	return u.MaxAge
+}
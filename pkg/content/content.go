@@ -7,6 +7,8 @@ package content
 import (
 	"context"
 	"io/ioutil"
+
+	"github.com/bborbe/world/pkg/template"
 )
 
 type HasContent interface {
@@ -34,3 +36,13 @@ func (f File) Content(ctx context.Context) ([]byte, error) {
 func (f File) String() string {
 	return string(f)
 }
+
+// Template renders the text/template with the data.
+type Template struct {
+	Template string
+	Data     interface{}
+}
+
+func (t Template) Content(ctx context.Context) ([]byte, error) {
+	return template.Render(t.Template, t.Data)
+}
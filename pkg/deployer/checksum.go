@@ -0,0 +1,162 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package deployer
+
+import (
+	"context"
+	"crypto/md5"
+	"encoding/hex"
+	"fmt"
+	"sort"
+
+	"github.com/pkg/errors"
+
+	"github.com/bborbe/world/pkg/k8s"
+	"github.com/bborbe/world/pkg/world"
+)
+
+// ChecksumDeploymentApplier adds the checksum annotations of the requirements to the pod template
+// of the Deployment each time it is checked or applied.
+// Only Deployments are covered, pkg/deployer has no StatefulSet deployer to add the checksums to.
+type ChecksumDeploymentApplier struct {
+	Applier      k8s.DeploymentApplier
+	Requirements []world.Configuration
+}
+
+func (c *ChecksumDeploymentApplier) KubernetesContext() k8s.Context {
+	return c.Applier.Context
+}
+
+func (c *ChecksumDeploymentApplier) Satisfied(ctx context.Context) (bool, error) {
+	applier, err := c.applier(ctx)
+	if err != nil {
+		return false, err
+	}
+	return applier.Satisfied(ctx)
+}
+
+func (c *ChecksumDeploymentApplier) Apply(ctx context.Context) error {
+	applier, err := c.applier(ctx)
+	if err != nil {
+		return err
+	}
+	return applier.Apply(ctx)
+}
+
+func (c *ChecksumDeploymentApplier) Validate(ctx context.Context) error {
+	return c.Applier.Validate(ctx)
+}
+
+func (c *ChecksumDeploymentApplier) applier(ctx context.Context) (*k8s.DeploymentApplier, error) {
+	applier := c.Applier
+	template := applier.Deployment.Spec.Template
+	checksums, err := ChecksumAnnotations(ctx, applier.Deployment.Metadata.Namespace, template.Spec, c.Requirements)
+	if err != nil {
+		return nil, err
+	}
+	if len(checksums) == 0 {
+		return &applier, nil
+	}
+	annotations := k8s.Annotations{}
+	for k, v := range template.Metadata.Annotations {
+		annotations[k] = v
+	}
+	for k, v := range checksums {
+		annotations[k] = v
+	}
+	applier.Deployment.Spec.Template.Metadata.Annotations = annotations
+	return &applier, nil
+}
+
+// ChecksumAnnotations returns a checksum annotation for each ConfigMapApplier and SecretApplier
+// of the requirements referenced by the pod spec. Added to the pod template a changed value
+// triggers a rollout. Referenced objects not deployed by the requirements are skipped.
+func ChecksumAnnotations(ctx context.Context, namespace k8s.NamespaceName, podSpec k8s.PodSpec, requirements []world.Configuration) (k8s.Annotations, error) {
+	configMaps, secrets := podReferences(podSpec)
+	annotations := k8s.Annotations{}
+	for _, requirement := range requirements {
+		err := world.Walk(ctx, requirement, func(configuration world.Configuration) error {
+			applier, err := configuration.Applier()
+			if err != nil {
+				return errors.Wrap(err, "get applier failed")
+			}
+			switch a := applier.(type) {
+			case *ConfigMapApplier:
+				if a.Namespace != namespace || !configMaps[a.Name.String()] {
+					return nil
+				}
+				checksum, err := a.ConfigValues.checksum(ctx)
+				if err != nil {
+					return errors.Wrapf(err, "checksum of configmap %s failed", a.Name)
+				}
+				annotations[fmt.Sprintf("checksum/configmap-%s", a.Name)] = checksum
+			case *SecretApplier:
+				if a.Namespace != namespace || !secrets[a.Name.String()] {
+					return nil
+				}
+				checksum, err := a.checksum(ctx)
+				if err != nil {
+					return errors.Wrapf(err, "checksum of secret %s failed", a.Name)
+				}
+				annotations[fmt.Sprintf("checksum/secret-%s", a.Name)] = checksum
+			}
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	return annotations, nil
+}
+
+// podReferences returns the names of all ConfigMaps and Secrets used in volumes, env and envFrom
+// of the containers and init containers of the pod.
+func podReferences(podSpec k8s.PodSpec) (map[string]bool, map[string]bool) {
+	configMaps := map[string]bool{}
+	secrets := map[string]bool{}
+	for _, volume := range podSpec.Volumes {
+		if volume.ConfigMap.Name != "" {
+			configMaps[string(volume.ConfigMap.Name)] = true
+		}
+		if volume.Secret.Name != "" {
+			secrets[string(volume.Secret.Name)] = true
+		}
+	}
+	containers := append(append([]k8s.Container{}, podSpec.InitContainers...), podSpec.Containers...)
+	for _, container := range containers {
+		for _, env := range container.Env {
+			if env.ValueFrom.ConfigMapKeyRef.Name != "" {
+				configMaps[env.ValueFrom.ConfigMapKeyRef.Name] = true
+			}
+			if env.ValueFrom.SecretKeyRef.Name != "" {
+				secrets[env.ValueFrom.SecretKeyRef.Name] = true
+			}
+		}
+		for _, envFrom := range container.EnvFrom {
+			if envFrom.ConfigMapRef.Name != "" {
+				configMaps[envFrom.ConfigMapRef.Name] = true
+			}
+			if envFrom.SecretRef.Name != "" {
+				secrets[envFrom.SecretRef.Name] = true
+			}
+		}
+	}
+	return configMaps, secrets
+}
+
+// checksum over keys and values in sorted key order.
+func checksum(data map[string][]byte) string {
+	keys := make([]string, 0, len(data))
+	for key := range data {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	hasher := md5.New()
+	for _, key := range keys {
+		fmt.Fprintf(hasher, "%d:%s%d:", len(key), key, len(data[key]))
+		hasher.Write(data[key])
+	}
+	return hex.EncodeToString(hasher.Sum(nil))
+}
@@ -6,8 +6,8 @@ package deployer
 
 import (
 	"context"
-	"crypto/md5"
-	"encoding/hex"
+
+	"github.com/pkg/errors"
 
 	"github.com/bborbe/world/pkg/k8s"
 	"github.com/bborbe/world/pkg/validation"
@@ -15,13 +15,26 @@ import (
 
 type ConfigValues map[string]ConfigValue
 
+// Checksum over all keys and values, values with error are empty.
 func (c ConfigValues) Checksum() string {
-	hasher := md5.New()
-	for _, value := range c {
+	data := map[string][]byte{}
+	for key, value := range c {
 		value, _ := value.Value(context.Background())
-		hasher.Write([]byte(value))
+		data[key] = []byte(value)
+	}
+	return checksum(data)
+}
+
+func (c ConfigValues) checksum(ctx context.Context) (string, error) {
+	data := map[string][]byte{}
+	for key, value := range c {
+		value, err := value.Value(ctx)
+		if err != nil {
+			return "", errors.Wrapf(err, "get value of %s failed", key)
+		}
+		data[key] = []byte(value)
 	}
-	return hex.EncodeToString(hasher.Sum(nil))
+	return checksum(data), nil
 }
 
 func (c ConfigValues) Validate(ctx context.Context) error {
@@ -61,13 +74,27 @@ func (s ConfigValueFunc) Validate(ctx context.Context) error {
 
 type ConfigMapApplier struct {
 	Context      k8s.Context
+	Overlays     k8s.Overlays
 	Namespace    k8s.NamespaceName
 	Name         k8s.MetadataName
 	ConfigValues ConfigValues
 }
 
+func (c *ConfigMapApplier) KubernetesContext() k8s.Context {
+	return c.Context
+}
+
 func (c *ConfigMapApplier) Satisfied(ctx context.Context) (bool, error) {
-	return false, nil
+	configmap, err := c.configmap(ctx)
+	if err != nil {
+		return false, err
+	}
+	applier := &k8s.ConfigMapApplier{
+		Context:   c.Context,
+		Overlays:  c.Overlays,
+		ConfigMap: *configmap,
+	}
+	return applier.Satisfied(ctx)
 }
 
 func (c *ConfigMapApplier) Apply(ctx context.Context) error {
@@ -77,6 +104,7 @@ func (c *ConfigMapApplier) Apply(ctx context.Context) error {
 	}
 	applier := &k8s.ConfigMapApplier{
 		Context:   c.Context,
+		Overlays:  c.Overlays,
 		ConfigMap: *configmap,
 	}
 	return applier.Apply(ctx)
@@ -109,6 +137,7 @@ func (c *ConfigMapApplier) Validate(ctx context.Context) error {
 	return validation.Validate(
 		ctx,
 		c.Context,
+		c.Overlays,
 		c.Name,
 		c.Namespace,
 		c.ConfigValues,
@@ -0,0 +1,89 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package deployer
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"github.com/bborbe/world/pkg/k8s"
+	"github.com/bborbe/world/pkg/validation"
+	"github.com/bborbe/world/pkg/world"
+)
+
+// CronJobDeployer runs the containers on the schedule. Overlapping runs are forbidden by default.
+type CronJobDeployer struct {
+	Context            k8s.Context
+	Overlays           k8s.Overlays
+	Namespace          k8s.NamespaceName
+	Name               k8s.MetadataName
+	Schedule           k8s.CronJobSchedule
+	ConcurrencyPolicy  k8s.CronJobConcurrencyPolicy
+	Containers         []HasContainer
+	Volumes            []k8s.PodVolume
+	RestartPolicy      k8s.RestartPolicy
+	BackoffLimit       *int
+	ServiceAccountName string
+	Requirements       []world.Configuration
+}
+
+func (c *CronJobDeployer) Validate(ctx context.Context) error {
+	if len(c.Containers) == 0 {
+		return errors.New("Containers empty")
+	}
+	for _, container := range c.Containers {
+		if err := container.Validate(ctx); err != nil {
+			return errors.Wrap(err, "validate container failed")
+		}
+	}
+	return validation.Validate(
+		ctx,
+		c.Context,
+		c.Namespace,
+		c.Name,
+		c.Schedule,
+		c.ConcurrencyPolicy,
+	)
+}
+
+func (c *CronJobDeployer) Applier() (world.Applier, error) {
+	return &k8s.CronJobApplier{
+		Context:  c.Context,
+		Overlays: c.Overlays,
+		CronJob:  c.cronJob(),
+	}, nil
+}
+
+func (c *CronJobDeployer) Children(ctx context.Context) (world.Configurations, error) {
+	return containerRequirements(c.Requirements, c.Containers), nil
+}
+
+func (c *CronJobDeployer) cronJob() k8s.CronJob {
+	concurrencyPolicy := c.ConcurrencyPolicy
+	if concurrencyPolicy == "" {
+		concurrencyPolicy = k8s.CronJobConcurrencyPolicyForbid
+	}
+	return k8s.CronJob{
+		ApiVersion: "batch/v1",
+		Kind:       "CronJob",
+		Metadata: k8s.Metadata{
+			Namespace: c.Namespace,
+			Name:      c.Name,
+			Labels: k8s.Labels{
+				"app": c.Name.String(),
+			},
+		},
+		Spec: k8s.CronJobSpec{
+			Schedule:                   c.Schedule,
+			ConcurrencyPolicy:          concurrencyPolicy,
+			SuccessfulJobsHistoryLimit: 1,
+			FailedJobsHistoryLimit:     1,
+			JobTemplate: k8s.JobTemplate{
+				Spec: jobSpec(c.Name, c.Containers, c.Volumes, c.RestartPolicy, c.BackoffLimit, c.ServiceAccountName),
+			},
+		},
+	}
+}
@@ -0,0 +1,39 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package deployer
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/bborbe/world/pkg/docker"
+	"github.com/bborbe/world/pkg/k8s"
+)
+
+var _ = Describe("CronJobDeployer", func() {
+	It("rejects invalid schedule", func() {
+		cronJobDeployer := &CronJobDeployer{
+			Context:   "banana",
+			Namespace: "backup",
+			Name:      "backup",
+			Schedule:  "0 3 * * *",
+			Containers: []HasContainer{
+				&DeploymentDeployerContainer{
+					Name:  "backup",
+					Image: docker.Image{Repository: "bborbe/backup", Tag: "1.0.0"},
+					Resources: k8s.Resources{
+						Limits:   k8s.ContainerResource{Cpu: "100m", Memory: "50Mi"},
+						Requests: k8s.ContainerResource{Cpu: "10m", Memory: "10Mi"},
+					},
+				},
+			},
+		}
+		Expect(cronJobDeployer.Validate(context.Background())).To(BeNil())
+		cronJobDeployer.Schedule = "every day"
+		Expect(cronJobDeployer.Validate(context.Background())).NotTo(BeNil())
+	})
+})
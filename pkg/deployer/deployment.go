@@ -74,6 +74,7 @@ type HasContainer interface {
 
 type DeploymentDeployer struct {
 	Context            k8s.Context
+	Overlays           k8s.Overlays
 	Namespace          k8s.NamespaceName
 	Name               k8s.MetadataName
 	Containers         []HasContainer
@@ -84,6 +85,7 @@ type DeploymentDeployer struct {
 	Annotations        k8s.Annotations
 	Strategy           k8s.DeploymentStrategy
 	ServiceAccountName string
+	Rollout            k8s.Rollout
 }
 
 func (d *DeploymentDeployer) Validate(ctx context.Context) error {
@@ -111,6 +113,7 @@ type DeploymentDeployerContainer struct {
 	Args            []k8s.Arg
 	Ports           []Port
 	Env             []k8s.Env
+	EnvFrom         []k8s.EnvFrom
 	Resources       k8s.Resources
 	Mounts          []k8s.ContainerMount
 	Image           docker.Image
@@ -125,9 +128,18 @@ func (d *DeploymentDeployerContainer) Validate(ctx context.Context) error {
 }
 
 func (d *DeploymentDeployer) Applier() (world.Applier, error) {
-	return &k8s.DeploymentApplier{
-		Context:    d.Context,
-		Deployment: d.deployment(),
+	requirements, err := d.Children(context.Background())
+	if err != nil {
+		return nil, err
+	}
+	return &ChecksumDeploymentApplier{
+		Applier: k8s.DeploymentApplier{
+			Context:    d.Context,
+			Overlays:   d.Overlays,
+			Deployment: d.deployment(),
+			Rollout:    d.Rollout,
+		},
+		Requirements: requirements,
 	}, nil
 }
 
@@ -225,6 +237,7 @@ func (d *DeploymentDeployerContainer) Container() k8s.Container {
 		Args:            d.Args,
 		Command:         d.Command,
 		Env:             d.Env,
+		EnvFrom:         d.EnvFrom,
 		SecurityContext: d.SecurityContext,
 	}
 	for _, port := range d.Ports {
@@ -6,6 +6,7 @@ package deployer
 
 import (
 	"bytes"
+	"context"
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
@@ -15,6 +16,7 @@ import (
 
 	"github.com/bborbe/world/pkg/docker"
 	"github.com/bborbe/world/pkg/k8s"
+	"github.com/bborbe/world/pkg/world"
 )
 
 var _ = Describe("DeploymentDeployer", func() {
@@ -152,4 +154,68 @@ spec:
           server: 127.0.0.1
 `))
 	})
+	Context("with secret requirement", func() {
+		var secrets Secrets
+		requirements := func() []world.Configuration {
+			return []world.Configuration{
+				world.NewConfiguraionBuilder().WithApplier(&SecretApplier{
+					Namespace: "banana",
+					Name:      "banana",
+					Secrets:   secrets,
+				}),
+				world.NewConfiguraionBuilder().WithApplier(&SecretApplier{
+					Namespace: "banana",
+					Name:      "unused",
+					Secrets:   secrets,
+				}),
+			}
+		}
+		BeforeEach(func() {
+			secrets = Secrets{
+				"password": SecretValueStatic("s3cr3t"),
+				"user":     SecretValueStatic("banana"),
+			}
+			deploymentDeployer.Volumes = append(deploymentDeployer.Volumes, k8s.PodVolume{
+				Name: "secret",
+				Secret: k8s.PodVolumeSecret{
+					Name: "banana",
+				},
+			})
+			deploymentDeployer.Requirements = requirements()
+		})
+		checksums := func() k8s.Annotations {
+			applier, err := deploymentDeployer.Applier()
+			Expect(err).NotTo(HaveOccurred())
+			deploymentApplier, err := applier.(*ChecksumDeploymentApplier).applier(context.Background())
+			Expect(err).NotTo(HaveOccurred())
+			deployment := deploymentApplier.Deployment
+			Expect(deployment.Metadata.Annotations).To(BeEmpty())
+			return deployment.Spec.Template.Metadata.Annotations
+		}
+		It("adds checksum of referenced secret to pod template", func() {
+			annotations := checksums()
+			Expect(annotations).To(HaveLen(1))
+			Expect(annotations).To(HaveKey("checksum/secret-banana"))
+			Expect(checksums()).To(Equal(annotations))
+		})
+		It("changes checksum if secret value changes", func() {
+			before := checksums()
+			secrets["password"] = SecretValueStatic("changed")
+			deploymentDeployer.Requirements = requirements()
+			Expect(checksums()).NotTo(Equal(before))
+		})
+		It("adds checksum of secret referenced by envFrom of init container", func() {
+			deploymentDeployer.Volumes = nil
+			podSpec := deploymentDeployer.deployment().Spec.Template.Spec
+			podSpec.InitContainers = []k8s.Container{
+				{
+					Name:    "init",
+					EnvFrom: []k8s.EnvFrom{{SecretRef: k8s.EnvFromRef{Name: "banana"}}},
+				},
+			}
+			annotations, err := ChecksumAnnotations(context.Background(), "banana", podSpec, deploymentDeployer.Requirements)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(annotations).To(HaveKey("checksum/secret-banana"))
+		})
+	})
 })
@@ -0,0 +1,440 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package deployer
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"strings"
+
+	"github.com/golang/glog"
+	"github.com/pkg/errors"
+	"gopkg.in/yaml.v2"
+
+	"github.com/bborbe/world/pkg/k8s"
+	"github.com/bborbe/world/pkg/validation"
+	"github.com/bborbe/world/pkg/world"
+)
+
+// HelmDeployer renders the chart with helm template and applies the objects with the k8s appliers.
+// The objects of the release are recorded in a ConfigMap, objects removed from the chart
+// are deleted on the next apply. With Uninstall all recorded objects are deleted.
+// Hooks and tests of the chart are not supported and skipped.
+type HelmDeployer struct {
+	Context   k8s.Context
+	Overlays  k8s.Overlays
+	Namespace k8s.NamespaceName
+	Release   k8s.MetadataName
+	// Chart is a chart directory or a packaged .tgz.
+	Chart string
+	// Values is encoded as yaml and passed to the chart.
+	Values interface{}
+	// SecretValues are set at the dotted path in the values, like "auth.password".
+	SecretValues map[string]SecretValue
+	Uninstall    bool
+	Requirements []world.Configuration
+}
+
+func (h *HelmDeployer) Validate(ctx context.Context) error {
+	return h.applier().Validate(ctx)
+}
+
+func (h *HelmDeployer) Applier() (world.Applier, error) {
+	return h.applier(), nil
+}
+
+func (h *HelmDeployer) Children(ctx context.Context) (world.Configurations, error) {
+	return h.Requirements, nil
+}
+
+func (h *HelmDeployer) applier() *HelmApplier {
+	return &HelmApplier{
+		Context:      h.Context,
+		Overlays:     h.Overlays,
+		Namespace:    h.Namespace,
+		Release:      h.Release,
+		Chart:        h.Chart,
+		Values:       h.Values,
+		SecretValues: h.SecretValues,
+		Uninstall:    h.Uninstall,
+	}
+}
+
+type HelmApplier struct {
+	Context      k8s.Context
+	Overlays     k8s.Overlays
+	Namespace    k8s.NamespaceName
+	Release      k8s.MetadataName
+	Chart        string
+	Values       interface{}
+	SecretValues map[string]SecretValue
+	Uninstall    bool
+}
+
+func (h *HelmApplier) KubernetesContext() k8s.Context {
+	return h.Context
+}
+
+func (h *HelmApplier) Satisfied(ctx context.Context) (bool, error) {
+	recorded, err := h.recorded(ctx)
+	if err != nil {
+		return false, err
+	}
+	if h.Uninstall {
+		return recorded == nil, nil
+	}
+	objects, err := h.objects(ctx)
+	if err != nil {
+		return false, err
+	}
+	if len(removedObjects(recorded, references(objects))) > 0 {
+		return false, nil
+	}
+	for _, object := range objects {
+		deployer := &k8s.Deployer{
+			Context:  h.Context,
+			Overlays: h.Overlays,
+			Data:     object,
+		}
+		satisfied, err := deployer.Satisfied(ctx)
+		if err != nil || !satisfied {
+			return false, err
+		}
+	}
+	return recorded != nil, nil
+}
+
+func (h *HelmApplier) Apply(ctx context.Context) error {
+	recorded, err := h.recorded(ctx)
+	if err != nil {
+		return err
+	}
+	if h.Uninstall {
+		if recorded == nil {
+			return nil
+		}
+		glog.V(1).Infof("uninstall release %s from %s", h.Release, h.Context)
+		if err := h.delete(ctx, recorded); err != nil {
+			return err
+		}
+		return h.recordDeployer().Delete(ctx)
+	}
+	objects, err := h.objects(ctx)
+	if err != nil {
+		return err
+	}
+	glog.V(1).Infof("install release %s with %d objects to %s", h.Release, len(objects), h.Context)
+	for _, object := range objects {
+		deployer := &k8s.Deployer{
+			Context:  h.Context,
+			Overlays: h.Overlays,
+			Data:     object,
+		}
+		if err := deployer.Apply(ctx); err != nil {
+			return err
+		}
+	}
+	refs := references(objects)
+	if err := h.delete(ctx, removedObjects(recorded, refs)); err != nil {
+		return err
+	}
+	return h.record(ctx, refs)
+}
+
+func (h *HelmApplier) Validate(ctx context.Context) error {
+	if !h.Uninstall {
+		if h.Chart == "" {
+			return errors.New("Chart missing")
+		}
+		if _, err := os.Stat(h.Chart); err != nil {
+			return errors.Wrapf(err, "chart %s not found", h.Chart)
+		}
+		for key, value := range h.SecretValues {
+			if key == "" {
+				return errors.New("secret value has no key")
+			}
+			if err := value.Validate(ctx); err != nil {
+				return errors.Wrapf(err, "secret value %s invalid", key)
+			}
+		}
+	}
+	return validation.Validate(
+		ctx,
+		h.Context,
+		h.Overlays,
+		h.Namespace,
+		h.Release,
+	)
+}
+
+// objects renders the chart and sets the namespace of all namespaced objects without one.
+func (h *HelmApplier) objects(ctx context.Context) ([]k8s.Unstructured, error) {
+	values, err := h.values(ctx)
+	if err != nil {
+		return nil, err
+	}
+	file, err := ioutil.TempFile("", "world-helm-values-*.yaml")
+	if err != nil {
+		return nil, errors.Wrap(err, "create values file failed")
+	}
+	defer os.Remove(file.Name())
+	if _, err := file.Write(values); err != nil {
+		file.Close()
+		return nil, errors.Wrap(err, "write values file failed")
+	}
+	if err := file.Close(); err != nil {
+		return nil, errors.Wrap(err, "close values file failed")
+	}
+	stdout := &bytes.Buffer{}
+	stderr := &bytes.Buffer{}
+	cmd := exec.CommandContext(ctx, "helm", "template", h.Release.String(), h.Chart, "--namespace", h.Namespace.String(), "--include-crds", "--skip-tests", "--values", file.Name())
+	cmd.Stdout = stdout
+	cmd.Stderr = stderr
+	if err := cmd.Run(); err != nil {
+		return nil, errors.Wrapf(err, "helm template %s failed: %s", h.Chart, strings.TrimSpace(stderr.String()))
+	}
+	rendered, err := k8s.ParseUnstructured(stdout.Bytes())
+	if err != nil {
+		return nil, errors.Wrapf(err, "parse chart %s failed", h.Chart)
+	}
+	objects, crdScopes, err := withoutHooks(rendered)
+	if err != nil {
+		return nil, errors.Wrapf(err, "parse chart %s failed", h.Chart)
+	}
+	for i, object := range objects {
+		ref, err := object.Reference()
+		if err != nil {
+			return nil, err
+		}
+		if ref.Namespace != "" {
+			continue
+		}
+		// kinds of CRDs in the chart are unknown to the api until the CRD is applied
+		namespaced, ok := crdScopes[crdKey(ref.ApiVersion, ref.Kind)]
+		if !ok {
+			namespaced, err = k8s.Namespaced(ctx, h.Context, ref.ApiVersion, ref.Kind)
+			if err != nil {
+				return nil, err
+			}
+		}
+		if namespaced {
+			objects[i] = object.WithNamespace(h.Namespace)
+		}
+	}
+	return objects, nil
+}
+
+// helmObject contains the fields of a rendered object needed for hooks and CRDs.
+type helmObject struct {
+	Kind     k8s.Kind `yaml:"kind"`
+	Metadata struct {
+		Annotations map[string]string `yaml:"annotations"`
+	} `yaml:"metadata"`
+	Spec struct {
+		Group string `yaml:"group"`
+		Scope string `yaml:"scope"`
+		Names struct {
+			Kind k8s.Kind `yaml:"kind"`
+		} `yaml:"names"`
+	} `yaml:"spec"`
+}
+
+// withoutHooks returns all objects without helm.sh/hook annotation and the scope of all CRDs in the chart,
+// true if the custom resources are namespaced.
+func withoutHooks(rendered []k8s.Unstructured) ([]k8s.Unstructured, map[string]bool, error) {
+	var objects []k8s.Unstructured
+	crdScopes := map[string]bool{}
+	for _, object := range rendered {
+		data, err := yaml.Marshal(object)
+		if err != nil {
+			return nil, nil, errors.Wrap(err, "encode object failed")
+		}
+		var header helmObject
+		if err := yaml.Unmarshal(data, &header); err != nil {
+			return nil, nil, errors.Wrapf(err, "parse %s failed", object)
+		}
+		if hook, ok := header.Metadata.Annotations["helm.sh/hook"]; ok {
+			glog.V(1).Infof("skip %s hook %s", hook, object)
+			continue
+		}
+		if header.Kind == "CustomResourceDefinition" {
+			crdScopes[fmt.Sprintf("%s/%s", header.Spec.Group, header.Spec.Names.Kind)] = header.Spec.Scope == "Namespaced"
+		}
+		objects = append(objects, object)
+	}
+	return objects, crdScopes, nil
+}
+
+// crdKey returns the group and kind of the apiVersion, like monitoring.coreos.com/ServiceMonitor.
+func crdKey(apiVersion k8s.ApiVersion, kind k8s.Kind) string {
+	group := string(apiVersion)
+	if pos := strings.LastIndex(group, "/"); pos != -1 {
+		group = group[:pos]
+	}
+	return fmt.Sprintf("%s/%s", group, kind)
+}
+
+// values returns the yaml of Values with the SecretValues set.
+func (h *HelmApplier) values(ctx context.Context) ([]byte, error) {
+	values := map[interface{}]interface{}{}
+	if h.Values != nil {
+		data, err := yaml.Marshal(h.Values)
+		if err != nil {
+			return nil, errors.Wrap(err, "encode values failed")
+		}
+		if err := yaml.Unmarshal(data, &values); err != nil {
+			return nil, errors.Wrap(err, "values must be a map")
+		}
+	}
+	for key, secretValue := range h.SecretValues {
+		value, err := secretValue.Value(ctx)
+		if err != nil {
+			return nil, errors.Wrapf(err, "get secret value %s failed", key)
+		}
+		if err := setValue(values, strings.Split(key, "."), string(value)); err != nil {
+			return nil, errors.Wrapf(err, "set secret value %s failed", key)
+		}
+	}
+	return yaml.Marshal(values)
+}
+
+func setValue(values map[interface{}]interface{}, path []string, value string) error {
+	if len(path) == 1 {
+		values[path[0]] = value
+		return nil
+	}
+	child, ok := values[path[0]]
+	if !ok {
+		child = map[interface{}]interface{}{}
+		values[path[0]] = child
+	}
+	childValues, ok := child.(map[interface{}]interface{})
+	if !ok {
+		return errors.Errorf("%s is not a map", path[0])
+	}
+	return setValue(childValues, path[1:], value)
+}
+
+// delete the objects in reverse order.
+func (h *HelmApplier) delete(ctx context.Context, refs []k8s.ObjectReference) error {
+	for i := len(refs) - 1; i >= 0; i-- {
+		deployer := &k8s.Deployer{
+			Context:  h.Context,
+			Overlays: h.Overlays,
+			Data:     referenceObject(refs[i]),
+		}
+		if err := deployer.Delete(ctx); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (h *HelmApplier) recordDeployer() *k8s.Deployer {
+	return &k8s.Deployer{
+		Context:  h.Context,
+		Overlays: h.Overlays,
+		Data: k8s.ConfigMap{
+			ApiVersion: "v1",
+			Kind:       "ConfigMap",
+			Metadata: k8s.Metadata{
+				Namespace: h.Namespace,
+				Name:      k8s.MetadataName(fmt.Sprintf("world-release-%s", h.Release)),
+			},
+		},
+	}
+}
+
+// recorded returns the objects of the last apply, nil if the release is not installed.
+func (h *HelmApplier) recorded(ctx context.Context) ([]k8s.ObjectReference, error) {
+	content, err := h.recordDeployer().Get(ctx)
+	if k8s.IsNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, errors.Wrapf(err, "get release %s failed", h.Release)
+	}
+	var configMap struct {
+		Data map[string]string `json:"data"`
+	}
+	if err := json.Unmarshal(content, &configMap); err != nil {
+		return nil, errors.Wrapf(err, "parse release %s failed", h.Release)
+	}
+	refs := []k8s.ObjectReference{}
+	if err := json.Unmarshal([]byte(configMap.Data["objects"]), &refs); err != nil {
+		return nil, errors.Wrapf(err, "parse objects of release %s failed", h.Release)
+	}
+	return refs, nil
+}
+
+func (h *HelmApplier) record(ctx context.Context, refs []k8s.ObjectReference) error {
+	objects, err := json.Marshal(refs)
+	if err != nil {
+		return errors.Wrap(err, "encode objects failed")
+	}
+	deployer := h.recordDeployer()
+	configMap := deployer.Data.(k8s.ConfigMap)
+	configMap.Metadata.Labels = k8s.Labels{
+		"app.kubernetes.io/instance":   h.Release.String(),
+		"app.kubernetes.io/managed-by": "world",
+	}
+	configMap.Data = k8s.ConfigMapData{
+		"chart":   h.Chart,
+		"objects": string(objects),
+	}
+	deployer.Data = configMap
+	return deployer.Apply(ctx)
+}
+
+func references(objects []k8s.Unstructured) []k8s.ObjectReference {
+	var result []k8s.ObjectReference
+	for _, object := range objects {
+		ref, err := object.Reference()
+		if err != nil {
+			continue
+		}
+		result = append(result, *ref)
+	}
+	return result
+}
+
+// removedObjects returns all recorded objects missing in the current objects.
+// The api version is ignored, an object moved to a new version of its api group is still the same object.
+func removedObjects(recorded []k8s.ObjectReference, current []k8s.ObjectReference) []k8s.ObjectReference {
+	exists := map[string]bool{}
+	for _, ref := range current {
+		exists[objectKey(ref)] = true
+	}
+	var result []k8s.ObjectReference
+	for _, ref := range recorded {
+		if !exists[objectKey(ref)] {
+			result = append(result, ref)
+		}
+	}
+	return result
+}
+
+func objectKey(ref k8s.ObjectReference) string {
+	return fmt.Sprintf("%s/%s/%s", crdKey(ref.ApiVersion, ref.Kind), ref.Namespace, ref.Name)
+}
+
+func referenceObject(ref k8s.ObjectReference) k8s.Unstructured {
+	metadata := yaml.MapSlice{
+		{Key: "name", Value: ref.Name.String()},
+	}
+	if ref.Namespace != "" {
+		metadata = append(metadata, yaml.MapItem{Key: "namespace", Value: ref.Namespace.String()})
+	}
+	return k8s.Unstructured{
+		{Key: "apiVersion", Value: string(ref.ApiVersion)},
+		{Key: "kind", Value: ref.Kind.String()},
+		{Key: "metadata", Value: metadata},
+	}
+}
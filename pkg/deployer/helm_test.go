@@ -0,0 +1,120 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package deployer
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/bborbe/world/pkg/k8s"
+)
+
+var _ = Describe("HelmDeployer", func() {
+	It("merges secret values into values", func() {
+		applier := &HelmApplier{
+			Values: struct {
+				Replicas int `yaml:"replicas"`
+				Auth     struct {
+					Username string `yaml:"username"`
+				} `yaml:"auth"`
+			}{
+				Replicas: 2,
+				Auth: struct {
+					Username string `yaml:"username"`
+				}{
+					Username: "admin",
+				},
+			},
+			SecretValues: map[string]SecretValue{
+				"auth.password":     SecretValueStatic("S3cr3t"),
+				"metrics.token.key": SecretValueStatic("abc"),
+			},
+		}
+		values, err := applier.values(context.Background())
+		Expect(err).To(BeNil())
+		Expect(string(values)).To(Equal(`auth:
+  password: S3cr3t
+  username: admin
+metrics:
+  token:
+    key: abc
+replicas: 2
+`))
+	})
+	It("fails if secret path is not a map", func() {
+		applier := &HelmApplier{
+			Values: map[string]string{"auth": "none"},
+			SecretValues: map[string]SecretValue{
+				"auth.password": SecretValueStatic("S3cr3t"),
+			},
+		}
+		_, err := applier.values(context.Background())
+		Expect(err).NotTo(BeNil())
+	})
+	It("returns removed objects", func() {
+		a := k8s.ObjectReference{ApiVersion: "v1", Kind: "Service", Namespace: "web", Name: "a"}
+		b := k8s.ObjectReference{ApiVersion: "apps/v1", Kind: "Deployment", Namespace: "web", Name: "b"}
+		Expect(removedObjects([]k8s.ObjectReference{a, b}, []k8s.ObjectReference{b})).To(Equal([]k8s.ObjectReference{a}))
+		Expect(removedObjects(nil, []k8s.ObjectReference{b})).To(BeEmpty())
+	})
+	It("ignores api version of removed objects", func() {
+		old := k8s.ObjectReference{ApiVersion: "policy/v1beta1", Kind: "PodDisruptionBudget", Namespace: "web", Name: "a"}
+		current := k8s.ObjectReference{ApiVersion: "policy/v1", Kind: "PodDisruptionBudget", Namespace: "web", Name: "a"}
+		Expect(removedObjects([]k8s.ObjectReference{old}, []k8s.ObjectReference{current})).To(BeEmpty())
+		moved := k8s.ObjectReference{ApiVersion: "extensions/v1beta1", Kind: "PodDisruptionBudget", Namespace: "web", Name: "a"}
+		Expect(removedObjects([]k8s.ObjectReference{moved}, []k8s.ObjectReference{current})).To(Equal([]k8s.ObjectReference{moved}))
+	})
+	It("builds object from reference", func() {
+		object := referenceObject(k8s.ObjectReference{ApiVersion: "v1", Kind: "Service", Namespace: "web", Name: "a"})
+		Expect(object.String()).To(Equal("Service/a in web"))
+	})
+	It("skips hooks and returns scope of crds", func() {
+		rendered, err := k8s.ParseUnstructured([]byte(`apiVersion: apiextensions.k8s.io/v1
+kind: CustomResourceDefinition
+metadata:
+  name: backups.example.com
+spec:
+  group: example.com
+  scope: Namespaced
+  names:
+    kind: Backup
+---
+apiVersion: example.com/v1
+kind: Backup
+metadata:
+  name: daily
+---
+apiVersion: batch/v1
+kind: Job
+metadata:
+  name: migrate
+  annotations:
+    helm.sh/hook: pre-upgrade
+`))
+		Expect(err).To(BeNil())
+		objects, crdScopes, err := withoutHooks(rendered)
+		Expect(err).To(BeNil())
+		Expect(objects).To(HaveLen(2))
+		Expect(objects[1].String()).To(Equal("Backup/daily"))
+		Expect(crdScopes).To(Equal(map[string]bool{"example.com/Backup": true}))
+		Expect(crdKey("example.com/v1", "Backup")).To(Equal("example.com/Backup"))
+	})
+	It("is a kubernetes applier", func() {
+		Expect(k8s.IsKubernetesApplier(&HelmApplier{})).To(BeTrue())
+		Expect(k8s.IsKubernetesApplier(&ChecksumDeploymentApplier{})).To(BeTrue())
+	})
+	It("requires chart unless uninstall", func() {
+		deployer := &HelmDeployer{
+			Context:   "test",
+			Namespace: "web",
+			Release:   "nginx",
+		}
+		Expect(deployer.Validate(context.Background())).NotTo(BeNil())
+		deployer.Uninstall = true
+		Expect(deployer.Validate(context.Background())).To(BeNil())
+	})
+})
@@ -0,0 +1,99 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package deployer
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"github.com/bborbe/world/pkg/k8s"
+	"github.com/bborbe/world/pkg/validation"
+	"github.com/bborbe/world/pkg/world"
+)
+
+// HorizontalPodAutoscalerDeployer scales the Deployment with the same name on cpu and memory utilization.
+type HorizontalPodAutoscalerDeployer struct {
+	Context           k8s.Context
+	Overlays          k8s.Overlays
+	Namespace         k8s.NamespaceName
+	Name              k8s.MetadataName
+	MinReplicas       k8s.Replicas
+	MaxReplicas       k8s.Replicas
+	CpuUtilization    int
+	MemoryUtilization int
+	Requirements      []world.Configuration
+}
+
+func (h *HorizontalPodAutoscalerDeployer) Validate(ctx context.Context) error {
+	if h.CpuUtilization == 0 && h.MemoryUtilization == 0 {
+		return errors.New("CpuUtilization or MemoryUtilization missing")
+	}
+	return validation.Validate(
+		ctx,
+		h.Context,
+		h.Namespace,
+		h.Name,
+		h.MinReplicas,
+		h.MaxReplicas,
+	)
+}
+
+func (h *HorizontalPodAutoscalerDeployer) Applier() (world.Applier, error) {
+	return &k8s.HorizontalPodAutoscalerApplier{
+		Context:                 h.Context,
+		Overlays:                h.Overlays,
+		HorizontalPodAutoscaler: h.horizontalPodAutoscaler(),
+	}, nil
+}
+
+func (h *HorizontalPodAutoscalerDeployer) Children(ctx context.Context) (world.Configurations, error) {
+	return h.Requirements, nil
+}
+
+func (h *HorizontalPodAutoscalerDeployer) horizontalPodAutoscaler() k8s.HorizontalPodAutoscaler {
+	result := k8s.HorizontalPodAutoscaler{
+		ApiVersion: "autoscaling/v2",
+		Kind:       "HorizontalPodAutoscaler",
+		Metadata: k8s.Metadata{
+			Namespace: h.Namespace,
+			Name:      h.Name,
+			Labels: k8s.Labels{
+				"app": h.Name.String(),
+			},
+		},
+		Spec: k8s.HorizontalPodAutoscalerSpec{
+			ScaleTargetRef: k8s.CrossVersionObjectReference{
+				ApiVersion: "apps/v1",
+				Kind:       "Deployment",
+				Name:       h.Name,
+			},
+			MinReplicas: h.MinReplicas,
+			MaxReplicas: h.MaxReplicas,
+		},
+	}
+	for _, metric := range []struct {
+		name        string
+		utilization int
+	}{
+		{name: "cpu", utilization: h.CpuUtilization},
+		{name: "memory", utilization: h.MemoryUtilization},
+	} {
+		if metric.utilization == 0 {
+			continue
+		}
+		result.Spec.Metrics = append(result.Spec.Metrics, k8s.MetricSpec{
+			Type: "Resource",
+			Resource: &k8s.ResourceMetricSource{
+				Name: metric.name,
+				Target: k8s.MetricTarget{
+					Type:               "Utilization",
+					AverageUtilization: metric.utilization,
+				},
+			},
+		})
+	}
+	return result
+}
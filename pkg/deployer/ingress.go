@@ -16,17 +16,27 @@ import (
 
 type IngressDeployer struct {
 	Context      k8s.Context
+	Overlays     k8s.Overlays
 	Namespace    k8s.NamespaceName
 	Name         k8s.MetadataName
 	Domains      k8s.IngressHosts
 	Port         k8s.PortName
 	Requirements []world.Configuration
+	// Version of the cluster, extensions/v1beta1 is used if empty.
+	Version k8s.Version
+	// IngressClassName is only used with networking.k8s.io/v1.
+	IngressClassName string
 }
 
 func (t *IngressDeployer) Validate(ctx context.Context) error {
 	if len(t.Domains) == 0 {
 		return errors.New("Domains empty")
 	}
+	if t.Version != "" {
+		if err := t.Version.Validate(ctx); err != nil {
+			return err
+		}
+	}
 	return validation.Validate(
 		ctx,
 		t.Context,
@@ -38,8 +48,9 @@ func (t *IngressDeployer) Validate(ctx context.Context) error {
 
 func (i *IngressDeployer) Applier() (world.Applier, error) {
 	return &k8s.IngressApplier{
-		Context: i.Context,
-		Ingress: i.ingress(),
+		Context:  i.Context,
+		Overlays: i.Overlays,
+		Ingress:  i.ingress(),
 	}, nil
 }
 
@@ -48,8 +59,9 @@ func (i *IngressDeployer) Children(ctx context.Context) (world.Configurations, e
 }
 
 func (i *IngressDeployer) ingress() k8s.Ingress {
+	apiVersion := i.Version.IngressApiVersion()
 	ingress := k8s.Ingress{
-		ApiVersion: "extensions/v1beta1",
+		ApiVersion: apiVersion,
 		Kind:       "Ingress",
 		Metadata: k8s.Metadata{
 			Namespace: i.Namespace,
@@ -60,18 +72,20 @@ func (i *IngressDeployer) ingress() k8s.Ingress {
 		},
 		Spec: k8s.IngressSpec{},
 	}
+	if apiVersion == k8s.IngressApiVersionV1 {
+		ingress.Spec.IngressClassName = i.IngressClassName
+	}
 	for _, domain := range i.Domains {
 		ingress.Spec.Rules = append(ingress.Spec.Rules, k8s.IngressRule{
 			Host: domain,
 			Http: k8s.IngressHttp{
 				Paths: []k8s.IngressPath{
-					{
-						Path: "/",
-						Backends: k8s.IngressBackend{
-							ServiceName: k8s.IngressBackendServiceName(i.Name),
-							ServicePort: k8s.IngressBackendServicePort(i.Port),
-						},
-					},
+					k8s.NewIngressPath(
+						apiVersion,
+						"/",
+						k8s.IngressBackendServiceName(i.Name),
+						k8s.IngressBackendServicePort(i.Port),
+					),
 				},
 			},
 		})
@@ -6,6 +6,7 @@ package deployer
 
 import (
 	"bytes"
+	"context"
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
@@ -17,6 +18,7 @@ import (
 var _ = Describe("IngressDeployer", func() {
 	It("ingress", func() {
 		ingressDeployer := &IngressDeployer{
+			Version:   "1.13",
 			Context:   "banana",
 			Namespace: "banana",
 			Name:      "banana",
@@ -44,4 +46,51 @@ spec:
         path: /
 `))
 	})
+	It("ingress networking.k8s.io/v1", func() {
+		ingressDeployer := &IngressDeployer{
+			Version:          "1.27",
+			Context:          "banana",
+			Namespace:        "banana",
+			Name:             "banana",
+			Port:             "http",
+			Domains:          k8s.IngressHosts{"example.com"},
+			IngressClassName: "traefik",
+		}
+		ingress := ingressDeployer.ingress()
+		Expect(ingress.Validate(context.Background())).To(BeNil())
+		b := &bytes.Buffer{}
+		err := yaml.NewEncoder(b).Encode(ingress)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(b.String()).To(Equal(`apiVersion: networking.k8s.io/v1
+kind: Ingress
+metadata:
+  namespace: banana
+  name: banana
+  labels:
+    app: banana
+spec:
+  ingressClassName: traefik
+  rules:
+  - host: example.com
+    http:
+      paths:
+      - backend:
+          service:
+            name: banana
+            port:
+              name: http
+        path: /
+        pathType: Prefix
+`))
+	})
+	It("keeps extensions/v1beta1 without version", func() {
+		ingressDeployer := &IngressDeployer{
+			Context:   "fire-k3s-dev",
+			Namespace: "banana",
+			Name:      "banana",
+			Port:      "http",
+			Domains:   k8s.IngressHosts{"example.com"},
+		}
+		Expect(ingressDeployer.ingress().ApiVersion).To(Equal(k8s.ApiVersion("extensions/v1beta1")))
+	})
 })
@@ -0,0 +1,109 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package deployer
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"github.com/bborbe/world/pkg/k8s"
+	"github.com/bborbe/world/pkg/validation"
+	"github.com/bborbe/world/pkg/world"
+)
+
+// JobDeployer runs the containers once until they succeed.
+type JobDeployer struct {
+	Context            k8s.Context
+	Overlays           k8s.Overlays
+	Namespace          k8s.NamespaceName
+	Name               k8s.MetadataName
+	Containers         []HasContainer
+	Volumes            []k8s.PodVolume
+	RestartPolicy      k8s.RestartPolicy
+	BackoffLimit       *int
+	ServiceAccountName string
+	Requirements       []world.Configuration
+}
+
+func (j *JobDeployer) Validate(ctx context.Context) error {
+	if len(j.Containers) == 0 {
+		return errors.New("Containers empty")
+	}
+	for _, container := range j.Containers {
+		if err := container.Validate(ctx); err != nil {
+			return errors.Wrap(err, "validate container failed")
+		}
+	}
+	return validation.Validate(
+		ctx,
+		j.Context,
+		j.Namespace,
+		j.Name,
+	)
+}
+
+func (j *JobDeployer) Applier() (world.Applier, error) {
+	return &k8s.JobApplier{
+		Context:  j.Context,
+		Overlays: j.Overlays,
+		Job:      j.job(),
+	}, nil
+}
+
+func (j *JobDeployer) Children(ctx context.Context) (world.Configurations, error) {
+	return containerRequirements(j.Requirements, j.Containers), nil
+}
+
+func (j *JobDeployer) job() k8s.Job {
+	return k8s.Job{
+		ApiVersion: "batch/v1",
+		Kind:       "Job",
+		Metadata: k8s.Metadata{
+			Namespace: j.Namespace,
+			Name:      j.Name,
+			Labels: k8s.Labels{
+				"app": j.Name.String(),
+			},
+		},
+		Spec: jobSpec(j.Name, j.Containers, j.Volumes, j.RestartPolicy, j.BackoffLimit, j.ServiceAccountName),
+	}
+}
+
+// jobSpec returns the spec of a Job, RestartPolicy defaults to OnFailure and a nil backoffLimit to the Kubernetes default.
+func jobSpec(name k8s.MetadataName, containers []HasContainer, volumes []k8s.PodVolume, restartPolicy k8s.RestartPolicy, backoffLimit *int, serviceAccountName string) k8s.JobSpec {
+	if restartPolicy == "" {
+		restartPolicy = k8s.RestartPolicyOnFailure
+	}
+	var podContainers []k8s.Container
+	for _, container := range containers {
+		podContainers = append(podContainers, container.Container())
+	}
+	return k8s.JobSpec{
+		BackoffLimit: backoffLimit,
+		Template: k8s.PodTemplate{
+			Metadata: k8s.Metadata{
+				Labels: k8s.Labels{
+					"app": name.String(),
+				},
+			},
+			Spec: k8s.PodSpec{
+				Containers:         podContainers,
+				Volumes:            volumes,
+				RestartPolicy:      restartPolicy,
+				ServiceAccountName: serviceAccountName,
+			},
+		},
+	}
+}
+
+func containerRequirements(requirements []world.Configuration, containers []HasContainer) world.Configurations {
+	var result []world.Configuration
+	result = append(result, requirements...)
+	for _, container := range containers {
+		result = append(result, container.Requirements()...)
+	}
+	return result
+}
@@ -0,0 +1,75 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package deployer
+
+import (
+	"bytes"
+	"context"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"gopkg.in/yaml.v2"
+
+	"github.com/bborbe/world/pkg/docker"
+	"github.com/bborbe/world/pkg/k8s"
+)
+
+var _ = Describe("JobDeployer", func() {
+	var jobDeployer *JobDeployer
+	BeforeEach(func() {
+		backoffLimit := 0
+		jobDeployer = &JobDeployer{
+			Context:      "banana",
+			Namespace:    "backup",
+			Name:         "backup",
+			BackoffLimit: &backoffLimit,
+			Containers: []HasContainer{
+				&DeploymentDeployerContainer{
+					Name:  "backup",
+					Image: docker.Image{Repository: "bborbe/backup", Tag: "1.0.0"},
+					Resources: k8s.Resources{
+						Limits:   k8s.ContainerResource{Cpu: "100m", Memory: "50Mi"},
+						Requests: k8s.ContainerResource{Cpu: "10m", Memory: "10Mi"},
+					},
+				},
+			},
+		}
+	})
+	It("job with backoff limit 0", func() {
+		b := &bytes.Buffer{}
+		err := yaml.NewEncoder(b).Encode(jobDeployer.job())
+		Expect(err).NotTo(HaveOccurred())
+		Expect(b.String()).To(Equal(`apiVersion: batch/v1
+kind: Job
+metadata:
+  namespace: backup
+  name: backup
+  labels:
+    app: backup
+spec:
+  backoffLimit: 0
+  template:
+    metadata:
+      labels:
+        app: backup
+    spec:
+      containers:
+      - name: backup
+        image: bborbe/backup:1.0.0
+        resources:
+          limits:
+            cpu: 100m
+            memory: 50Mi
+          requests:
+            cpu: 10m
+            memory: 10Mi
+      restartPolicy: OnFailure
+`))
+	})
+	It("is valid", func() {
+		Expect(jobDeployer.Validate(context.Background())).To(BeNil())
+		Expect(jobDeployer.job().Validate(context.Background())).To(BeNil())
+	})
+})
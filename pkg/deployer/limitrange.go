@@ -0,0 +1,72 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package deployer
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"github.com/bborbe/world/pkg/k8s"
+	"github.com/bborbe/world/pkg/validation"
+	"github.com/bborbe/world/pkg/world"
+)
+
+// LimitRangeDeployer sets default limits and requests for containers without resources.
+type LimitRangeDeployer struct {
+	Context         k8s.Context
+	Overlays        k8s.Overlays
+	Namespace       k8s.NamespaceName
+	Name            k8s.MetadataName
+	DefaultLimits   k8s.ResourceList
+	DefaultRequests k8s.ResourceList
+	Max             k8s.ResourceList
+	Requirements    []world.Configuration
+}
+
+func (l *LimitRangeDeployer) Validate(ctx context.Context) error {
+	if len(l.DefaultLimits) == 0 && len(l.DefaultRequests) == 0 && len(l.Max) == 0 {
+		return errors.New("DefaultLimits, DefaultRequests or Max required")
+	}
+	return validation.Validate(
+		ctx,
+		l.Context,
+		l.Namespace,
+		l.Name,
+	)
+}
+
+func (l *LimitRangeDeployer) Applier() (world.Applier, error) {
+	return &k8s.LimitRangeApplier{
+		Context:    l.Context,
+		Overlays:   l.Overlays,
+		LimitRange: l.limitRange(),
+	}, nil
+}
+
+func (l *LimitRangeDeployer) Children(ctx context.Context) (world.Configurations, error) {
+	return l.Requirements, nil
+}
+
+func (l *LimitRangeDeployer) limitRange() k8s.LimitRange {
+	return k8s.LimitRange{
+		ApiVersion: "v1",
+		Kind:       "LimitRange",
+		Metadata: k8s.Metadata{
+			Namespace: l.Namespace,
+			Name:      l.Name,
+		},
+		Spec: k8s.LimitRangeSpec{
+			Limits: []k8s.LimitRangeItem{
+				{
+					Type:           k8s.LimitTypeContainer,
+					Default:        l.DefaultLimits,
+					DefaultRequest: l.DefaultRequests,
+					Max:            l.Max,
+				},
+			},
+		},
+	}
+}
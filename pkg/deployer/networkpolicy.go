@@ -0,0 +1,102 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package deployer
+
+import (
+	"context"
+
+	"github.com/bborbe/world/pkg/k8s"
+	"github.com/bborbe/world/pkg/validation"
+	"github.com/bborbe/world/pkg/world"
+)
+
+// NetworkPolicyDeployer restricts ingress of the selected pods to the allowed namespaces.
+// Without allowed namespaces all ingress is denied.
+type NetworkPolicyDeployer struct {
+	Context   k8s.Context
+	Overlays  k8s.Overlays
+	Namespace k8s.NamespaceName
+	Name      k8s.MetadataName
+	// PodSelector selects the pods of the policy, all pods of the namespace if empty.
+	PodSelector        k8s.Labels
+	AllowSameNamespace bool
+	AllowNamespaces    []k8s.NamespaceName
+	Ports              []Port
+	Requirements       []world.Configuration
+}
+
+func (n *NetworkPolicyDeployer) Validate(ctx context.Context) error {
+	for _, namespace := range n.AllowNamespaces {
+		if err := namespace.Validate(ctx); err != nil {
+			return err
+		}
+	}
+	return validation.Validate(
+		ctx,
+		n.Context,
+		n.Namespace,
+		n.Name,
+	)
+}
+
+func (n *NetworkPolicyDeployer) Applier() (world.Applier, error) {
+	return &k8s.NetworkPolicyApplier{
+		Context:       n.Context,
+		Overlays:      n.Overlays,
+		NetworkPolicy: n.networkPolicy(),
+	}, nil
+}
+
+func (n *NetworkPolicyDeployer) Children(ctx context.Context) (world.Configurations, error) {
+	return n.Requirements, nil
+}
+
+func (n *NetworkPolicyDeployer) networkPolicy() k8s.NetworkPolicy {
+	result := k8s.NetworkPolicy{
+		ApiVersion: "networking.k8s.io/v1",
+		Kind:       "NetworkPolicy",
+		Metadata: k8s.Metadata{
+			Namespace: n.Namespace,
+			Name:      n.Name,
+		},
+		Spec: k8s.NetworkPolicySpec{
+			PodSelector: k8s.LabelSelector{
+				MatchLabels: n.PodSelector,
+			},
+			PolicyTypes: []k8s.NetworkPolicyType{
+				k8s.NetworkPolicyTypeIngress,
+			},
+		},
+	}
+	var peers []k8s.NetworkPolicyPeer
+	if n.AllowSameNamespace {
+		peers = append(peers, k8s.NetworkPolicyPeer{
+			PodSelector: &k8s.LabelSelector{},
+		})
+	}
+	for _, namespace := range n.AllowNamespaces {
+		peers = append(peers, k8s.NetworkPolicyPeer{
+			NamespaceSelector: &k8s.LabelSelector{
+				MatchLabels: k8s.Labels{
+					"kubernetes.io/metadata.name": namespace.String(),
+				},
+			},
+		})
+	}
+	if len(peers) == 0 {
+		return result
+	}
+	rule := k8s.NetworkPolicyIngressRule{
+		From: peers,
+	}
+	for _, port := range n.Ports {
+		rule.Ports = append(rule.Ports, k8s.NetworkPolicyPort{
+			Protocol: port.Protocol,
+			Port:     k8s.IntOrString(port.Port.String()),
+		})
+	}
+	result.Spec.Ingress = append(result.Spec.Ingress, rule)
+	return result
+}
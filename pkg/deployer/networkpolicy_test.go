@@ -0,0 +1,20 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package deployer
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("NetworkPolicyDeployer", func() {
+	It("denies all ingress without allowed namespaces", func() {
+		networkPolicyDeployer := &NetworkPolicyDeployer{
+			Namespace: "db",
+			Name:      "deny-all",
+		}
+		Expect(networkPolicyDeployer.networkPolicy().Spec.Ingress).To(BeEmpty())
+	})
+})
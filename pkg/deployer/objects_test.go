@@ -0,0 +1,353 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package deployer
+
+import (
+	"bytes"
+	"context"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"gopkg.in/yaml.v2"
+
+	"github.com/bborbe/world/pkg/docker"
+	"github.com/bborbe/world/pkg/k8s"
+)
+
+type validator interface {
+	Validate(ctx context.Context) error
+}
+
+var _ = Describe("Deployer objects", func() {
+	entries := []struct {
+		kind     string
+		deployer validator
+		object   func(deployer validator) validator
+		yaml     string
+	}{
+		{
+			kind: "LimitRange",
+			deployer: &LimitRangeDeployer{
+				Context:   "banana",
+				Namespace: "web",
+				Name:      "limits",
+				DefaultLimits: k8s.ResourceList{
+					"cpu":    "100m",
+					"memory": "64Mi",
+				},
+				DefaultRequests: k8s.ResourceList{
+					"cpu":    "10m",
+					"memory": "32Mi",
+				},
+			},
+			object: func(deployer validator) validator {
+				object := deployer.(*LimitRangeDeployer).limitRange()
+				return &object
+			},
+			yaml: `apiVersion: v1
+kind: LimitRange
+metadata:
+  namespace: web
+  name: limits
+spec:
+  limits:
+  - type: Container
+    default:
+      cpu: 100m
+      memory: 64Mi
+    defaultRequest:
+      cpu: 10m
+      memory: 32Mi
+`,
+		},
+		{
+			kind: "ResourceQuota",
+			deployer: &ResourceQuotaDeployer{
+				Context:   "banana",
+				Namespace: "web",
+				Name:      "quota",
+				Hard: k8s.ResourceList{
+					"pods":          "10",
+					"limits.memory": "2Gi",
+				},
+			},
+			object: func(deployer validator) validator {
+				object := deployer.(*ResourceQuotaDeployer).resourceQuota()
+				return &object
+			},
+			yaml: `apiVersion: v1
+kind: ResourceQuota
+metadata:
+  namespace: web
+  name: quota
+spec:
+  hard:
+    limits.memory: 2Gi
+    pods: "10"
+`,
+		},
+		{
+			kind: "NetworkPolicy",
+			deployer: &NetworkPolicyDeployer{
+				Context:            "banana",
+				Namespace:          "db",
+				Name:               "db",
+				AllowSameNamespace: true,
+				AllowNamespaces:    []k8s.NamespaceName{"app"},
+				Ports: []Port{
+					{
+						Name:     "postgres",
+						Port:     5432,
+						Protocol: "TCP",
+					},
+				},
+			},
+			object: func(deployer validator) validator {
+				object := deployer.(*NetworkPolicyDeployer).networkPolicy()
+				return &object
+			},
+			yaml: `apiVersion: networking.k8s.io/v1
+kind: NetworkPolicy
+metadata:
+  namespace: db
+  name: db
+spec:
+  podSelector: {}
+  policyTypes:
+  - Ingress
+  ingress:
+  - from:
+    - podSelector: {}
+    - namespaceSelector:
+        matchLabels:
+          kubernetes.io/metadata.name: app
+    ports:
+    - protocol: TCP
+      port: 5432
+`,
+		},
+		{
+			kind: "PersistentVolume",
+			deployer: &PersistentVolumeDeployer{
+				Context:          "banana",
+				Name:             "data",
+				Storage:          "10Gi",
+				StorageClassName: "nfs",
+				NfsServer:        "nfs.example.com",
+				NfsPath:          "/data",
+			},
+			object: func(deployer validator) validator {
+				object := deployer.(*PersistentVolumeDeployer).persistentVolume()
+				return &object
+			},
+			yaml: `apiVersion: v1
+kind: PersistentVolume
+metadata:
+  name: data
+spec:
+  capacity:
+    storage: 10Gi
+  accessModes:
+  - ReadWriteOnce
+  persistentVolumeReclaimPolicy: Retain
+  storageClassName: nfs
+  nfs:
+    path: /data
+    server: nfs.example.com
+`,
+		},
+		{
+			kind: "PersistentVolumeClaim",
+			deployer: &PersistentVolumeClaimDeployer{
+				Context:          "banana",
+				Namespace:        "web",
+				Name:             "data",
+				Storage:          "10Gi",
+				StorageClassName: "nfs",
+				VolumeName:       "data",
+			},
+			object: func(deployer validator) validator {
+				object := deployer.(*PersistentVolumeClaimDeployer).persistentVolumeClaim()
+				return &object
+			},
+			yaml: `apiVersion: v1
+kind: PersistentVolumeClaim
+metadata:
+  namespace: web
+  name: data
+  labels:
+    app: web
+spec:
+  accessModes:
+  - ReadWriteOnce
+  storageClassName: nfs
+  volumeName: data
+  resources:
+    requests:
+      storage: 10Gi
+`,
+		},
+		{
+			kind: "PodMonitor",
+			deployer: &PodMonitorDeployer{
+				Context:   "banana",
+				Namespace: "web",
+				Name:      "web",
+				Port:      "http",
+			},
+			object: func(deployer validator) validator {
+				object := deployer.(*PodMonitorDeployer).podMonitor()
+				return &object
+			},
+			yaml: `apiVersion: monitoring.coreos.com/v1
+kind: PodMonitor
+metadata:
+  namespace: web
+  name: web
+  labels:
+    app: web
+spec:
+  selector:
+    matchLabels:
+      app: web
+  podMetricsEndpoints:
+  - port: http
+`,
+		},
+		{
+			kind: "ServiceMonitor",
+			deployer: &ServiceMonitorDeployer{
+				Context:   "banana",
+				Namespace: "web",
+				Name:      "web",
+				Port:      "http",
+			},
+			object: func(deployer validator) validator {
+				object := deployer.(*ServiceMonitorDeployer).serviceMonitor()
+				return &object
+			},
+			yaml: `apiVersion: monitoring.coreos.com/v1
+kind: ServiceMonitor
+metadata:
+  namespace: web
+  name: web
+  labels:
+    app: web
+spec:
+  selector:
+    matchLabels:
+      app: web
+  endpoints:
+  - port: http
+`,
+		},
+		{
+			kind: "HorizontalPodAutoscaler",
+			deployer: &HorizontalPodAutoscalerDeployer{
+				Context:        "banana",
+				Namespace:      "web",
+				Name:           "web",
+				MinReplicas:    1,
+				MaxReplicas:    3,
+				CpuUtilization: 80,
+			},
+			object: func(deployer validator) validator {
+				object := deployer.(*HorizontalPodAutoscalerDeployer).horizontalPodAutoscaler()
+				return &object
+			},
+			yaml: `apiVersion: autoscaling/v2
+kind: HorizontalPodAutoscaler
+metadata:
+  namespace: web
+  name: web
+  labels:
+    app: web
+spec:
+  scaleTargetRef:
+    apiVersion: apps/v1
+    kind: Deployment
+    name: web
+  minReplicas: 1
+  maxReplicas: 3
+  metrics:
+  - type: Resource
+    resource:
+      name: cpu
+      target:
+        type: Utilization
+        averageUtilization: 80
+`,
+		},
+		{
+			kind: "CronJob",
+			deployer: &CronJobDeployer{
+				Context:   "banana",
+				Namespace: "backup",
+				Name:      "backup",
+				Schedule:  "0 3 * * *",
+				Containers: []HasContainer{
+					&DeploymentDeployerContainer{
+						Name:  "backup",
+						Image: docker.Image{Repository: "bborbe/backup", Tag: "1.0.0"},
+						Resources: k8s.Resources{
+							Limits:   k8s.ContainerResource{Cpu: "100m", Memory: "50Mi"},
+							Requests: k8s.ContainerResource{Cpu: "10m", Memory: "10Mi"},
+						},
+					},
+				},
+			},
+			object: func(deployer validator) validator {
+				object := deployer.(*CronJobDeployer).cronJob()
+				return &object
+			},
+			yaml: `apiVersion: batch/v1
+kind: CronJob
+metadata:
+  namespace: backup
+  name: backup
+  labels:
+    app: backup
+spec:
+  schedule: 0 3 * * *
+  concurrencyPolicy: Forbid
+  successfulJobsHistoryLimit: 1
+  failedJobsHistoryLimit: 1
+  jobTemplate:
+    spec:
+      template:
+        metadata:
+          labels:
+            app: backup
+        spec:
+          containers:
+          - name: backup
+            image: bborbe/backup:1.0.0
+            resources:
+              limits:
+                cpu: 100m
+                memory: 50Mi
+              requests:
+                cpu: 10m
+                memory: 10Mi
+          restartPolicy: OnFailure
+`,
+		},
+	}
+	for _, entry := range entries {
+		entry := entry
+		Context(entry.kind, func() {
+			It("renders the object", func() {
+				b := &bytes.Buffer{}
+				err := yaml.NewEncoder(b).Encode(entry.object(entry.deployer))
+				Expect(err).NotTo(HaveOccurred())
+				Expect(b.String()).To(Equal(entry.yaml))
+			})
+			It("is valid", func() {
+				Expect(entry.deployer.Validate(context.Background())).To(BeNil())
+				Expect(entry.object(entry.deployer).Validate(context.Background())).To(BeNil())
+			})
+		})
+	}
+})
@@ -0,0 +1,88 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package deployer
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"github.com/bborbe/world/pkg/k8s"
+	"github.com/bborbe/world/pkg/validation"
+	"github.com/bborbe/world/pkg/world"
+)
+
+// PersistentVolumeDeployer provides storage on a host path or nfs share.
+// The volume is retained after its claim is deleted.
+type PersistentVolumeDeployer struct {
+	Context          k8s.Context
+	Overlays         k8s.Overlays
+	Name             k8s.MetadataName
+	Storage          k8s.Storage
+	StorageClassName k8s.StorageClassName
+	AccessModes      []k8s.AccessMode
+	HostPath         k8s.PodHostPath
+	NfsServer        k8s.PodNfsServer
+	NfsPath          k8s.PodNfsPath
+	Requirements     []world.Configuration
+}
+
+func (p *PersistentVolumeDeployer) Validate(ctx context.Context) error {
+	if (p.HostPath == "") == (p.NfsServer == "") {
+		return errors.New("either HostPath or NfsServer required")
+	}
+	if p.NfsServer != "" {
+		if err := p.NfsPath.Validate(ctx); err != nil {
+			return err
+		}
+	}
+	return validation.Validate(
+		ctx,
+		p.Context,
+		p.Name,
+		p.Storage,
+	)
+}
+
+func (p *PersistentVolumeDeployer) Applier() (world.Applier, error) {
+	return &k8s.PersistentVolumeApplier{
+		Context:          p.Context,
+		Overlays:         p.Overlays,
+		PersistentVolume: p.persistentVolume(),
+	}, nil
+}
+
+func (p *PersistentVolumeDeployer) Children(ctx context.Context) (world.Configurations, error) {
+	return p.Requirements, nil
+}
+
+func (p *PersistentVolumeDeployer) persistentVolume() k8s.PersistentVolume {
+	result := k8s.PersistentVolume{
+		ApiVersion: "v1",
+		Kind:       "PersistentVolume",
+		Metadata: k8s.Metadata{
+			Name: p.Name,
+		},
+		Spec: k8s.PersistentVolumeSpec{
+			Capacity: k8s.PersistentVolumeCapacity{
+				Storage: p.Storage,
+			},
+			AccessModes:                   accessModes(p.AccessModes),
+			PersistentVolumeReclaimPolicy: k8s.PersistentVolumeReclaimPolicyRetain,
+			StorageClassName:              p.StorageClassName,
+		},
+	}
+	if p.HostPath != "" {
+		result.Spec.HostPath = &k8s.PodVolumeHost{
+			Path: p.HostPath,
+		}
+	} else {
+		result.Spec.Nfs = &k8s.PodVolumeNfs{
+			Server: p.NfsServer,
+			Path:   p.NfsPath,
+		}
+	}
+	return result
+}
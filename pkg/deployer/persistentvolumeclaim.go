@@ -0,0 +1,79 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package deployer
+
+import (
+	"context"
+
+	"github.com/bborbe/world/pkg/k8s"
+	"github.com/bborbe/world/pkg/validation"
+	"github.com/bborbe/world/pkg/world"
+)
+
+// PersistentVolumeClaimDeployer requests storage, AccessModes defaults to ReadWriteOnce.
+type PersistentVolumeClaimDeployer struct {
+	Context          k8s.Context
+	Overlays         k8s.Overlays
+	Namespace        k8s.NamespaceName
+	Name             k8s.MetadataName
+	Storage          k8s.Storage
+	StorageClassName k8s.StorageClassName
+	AccessModes      []k8s.AccessMode
+	VolumeName       k8s.MetadataName
+	Requirements     []world.Configuration
+}
+
+func (p *PersistentVolumeClaimDeployer) Validate(ctx context.Context) error {
+	return validation.Validate(
+		ctx,
+		p.Context,
+		p.Namespace,
+		p.Name,
+		p.Storage,
+	)
+}
+
+func (p *PersistentVolumeClaimDeployer) Applier() (world.Applier, error) {
+	return &k8s.PersistentVolumeClaimApplier{
+		Context:               p.Context,
+		Overlays:              p.Overlays,
+		PersistentVolumeClaim: p.persistentVolumeClaim(),
+	}, nil
+}
+
+func (p *PersistentVolumeClaimDeployer) Children(ctx context.Context) (world.Configurations, error) {
+	return p.Requirements, nil
+}
+
+func (p *PersistentVolumeClaimDeployer) persistentVolumeClaim() k8s.PersistentVolumeClaim {
+	return k8s.PersistentVolumeClaim{
+		ApiVersion: "v1",
+		Kind:       "PersistentVolumeClaim",
+		Metadata: k8s.Metadata{
+			Namespace: p.Namespace,
+			Name:      p.Name,
+			Labels: k8s.Labels{
+				"app": p.Namespace.String(),
+			},
+		},
+		Spec: k8s.PersistentVolumeClaimSpec{
+			AccessModes:      accessModes(p.AccessModes),
+			StorageClassName: p.StorageClassName,
+			VolumeName:       p.VolumeName,
+			Resources: k8s.VolumeClaimTemplatesSpecResources{
+				Requests: k8s.VolumeClaimTemplatesSpecResourcesRequests{
+					Storage: p.Storage,
+				},
+			},
+		},
+	}
+}
+
+func accessModes(accessModes []k8s.AccessMode) []k8s.AccessMode {
+	if len(accessModes) == 0 {
+		return []k8s.AccessMode{"ReadWriteOnce"}
+	}
+	return accessModes
+}
@@ -0,0 +1,75 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package deployer
+
+import (
+	"context"
+
+	"github.com/bborbe/world/pkg/k8s"
+	"github.com/bborbe/world/pkg/validation"
+	"github.com/bborbe/world/pkg/world"
+)
+
+// PodMonitorDeployer lets prometheus scrape the port of all pods labeled with app name.
+type PodMonitorDeployer struct {
+	Context      k8s.Context
+	Overlays     k8s.Overlays
+	Namespace    k8s.NamespaceName
+	Name         k8s.MetadataName
+	Port         k8s.PortName
+	Path         string
+	Interval     string
+	Requirements []world.Configuration
+}
+
+func (p *PodMonitorDeployer) Validate(ctx context.Context) error {
+	return validation.Validate(
+		ctx,
+		p.Context,
+		p.Namespace,
+		p.Name,
+		p.Port,
+	)
+}
+
+func (p *PodMonitorDeployer) Applier() (world.Applier, error) {
+	return &k8s.PodMonitorApplier{
+		Context:    p.Context,
+		Overlays:   p.Overlays,
+		PodMonitor: p.podMonitor(),
+	}, nil
+}
+
+func (p *PodMonitorDeployer) Children(ctx context.Context) (world.Configurations, error) {
+	return p.Requirements, nil
+}
+
+func (p *PodMonitorDeployer) podMonitor() k8s.PodMonitor {
+	return k8s.PodMonitor{
+		ApiVersion: "monitoring.coreos.com/v1",
+		Kind:       "PodMonitor",
+		Metadata: k8s.Metadata{
+			Namespace: p.Namespace,
+			Name:      p.Name,
+			Labels: k8s.Labels{
+				"app": p.Name.String(),
+			},
+		},
+		Spec: k8s.PodMonitorSpec{
+			Selector: k8s.LabelSelector{
+				MatchLabels: k8s.Labels{
+					"app": p.Name.String(),
+				},
+			},
+			PodMetricsEndpoints: []k8s.MonitorEndpoint{
+				{
+					Port:     p.Port,
+					Path:     p.Path,
+					Interval: p.Interval,
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,59 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package deployer
+
+import (
+	"context"
+
+	"github.com/bborbe/world/pkg/k8s"
+	"github.com/bborbe/world/pkg/validation"
+	"github.com/bborbe/world/pkg/world"
+)
+
+// ResourceQuotaDeployer limits the total resources of the namespace.
+type ResourceQuotaDeployer struct {
+	Context      k8s.Context
+	Overlays     k8s.Overlays
+	Namespace    k8s.NamespaceName
+	Name         k8s.MetadataName
+	Hard         k8s.ResourceList
+	Requirements []world.Configuration
+}
+
+func (r *ResourceQuotaDeployer) Validate(ctx context.Context) error {
+	return validation.Validate(
+		ctx,
+		r.Context,
+		r.Namespace,
+		r.Name,
+		r.Hard,
+	)
+}
+
+func (r *ResourceQuotaDeployer) Applier() (world.Applier, error) {
+	return &k8s.ResourceQuotaApplier{
+		Context:       r.Context,
+		Overlays:      r.Overlays,
+		ResourceQuota: r.resourceQuota(),
+	}, nil
+}
+
+func (r *ResourceQuotaDeployer) Children(ctx context.Context) (world.Configurations, error) {
+	return r.Requirements, nil
+}
+
+func (r *ResourceQuotaDeployer) resourceQuota() k8s.ResourceQuota {
+	return k8s.ResourceQuota{
+		ApiVersion: "v1",
+		Kind:       "ResourceQuota",
+		Metadata: k8s.Metadata{
+			Namespace: r.Namespace,
+			Name:      r.Name,
+		},
+		Spec: k8s.ResourceQuotaSpec{
+			Hard: r.Hard,
+		},
+	}
+}
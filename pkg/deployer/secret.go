@@ -9,6 +9,7 @@ import (
 	"encoding/base64"
 	"io/ioutil"
 	"os"
+	"sync"
 
 	"github.com/bborbe/teamvault-utils/v4"
 	"github.com/pkg/errors"
@@ -160,20 +161,38 @@ func (s Secrets) Validate(ctx context.Context) error {
 
 type SecretApplier struct {
 	Context      k8s.Context
+	Overlays     k8s.Overlays
 	Namespace    k8s.NamespaceName
 	Name         k8s.MetadataName
 	Secrets      Secrets
 	Requirements []world.Configuration
+
+	mux    sync.Mutex
+	cached *k8s.Secret
+}
+
+func (s *SecretApplier) KubernetesContext() k8s.Context {
+	return s.Context
 }
 
 func (s *SecretApplier) Satisfied(ctx context.Context) (bool, error) {
-	return false, nil
+	secret, err := s.secret(ctx)
+	if err != nil {
+		return false, err
+	}
+	applier := &k8s.SecretApplier{
+		Context:  s.Context,
+		Overlays: s.Overlays,
+		Secret:   *secret,
+	}
+	return applier.Satisfied(ctx)
 }
 
 func (s *SecretApplier) Validate(ctx context.Context) error {
 	return validation.Validate(
 		ctx,
 		s.Context,
+		s.Overlays,
 		s.Namespace,
 		s.Name,
 		s.Secrets,
@@ -186,8 +205,9 @@ func (s *SecretApplier) Apply(ctx context.Context) error {
 		return err
 	}
 	applier := &k8s.SecretApplier{
-		Context: s.Context,
-		Secret:  *secret,
+		Context:  s.Context,
+		Overlays: s.Overlays,
+		Secret:   *secret,
 	}
 	return applier.Apply(ctx)
 }
@@ -196,7 +216,26 @@ func (s *SecretApplier) Children(ctx context.Context) (world.Configurations, err
 	return s.Requirements, nil
 }
 
+// checksum over all keys and values of the secret.
+func (s *SecretApplier) checksum(ctx context.Context) (string, error) {
+	secret, err := s.secret(ctx)
+	if err != nil {
+		return "", err
+	}
+	data := map[string][]byte{}
+	for k, v := range secret.Data {
+		data[k] = []byte(v)
+	}
+	return checksum(data), nil
+}
+
+// secret is built once, so Satisfied, Apply and the checksum read the secret values only once per run.
 func (s *SecretApplier) secret(ctx context.Context) (*k8s.Secret, error) {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+	if s.cached != nil {
+		return s.cached, nil
+	}
 	secret := &k8s.Secret{
 		ApiVersion: "v1",
 		Kind:       "Secret",
@@ -217,5 +256,6 @@ func (s *SecretApplier) secret(ctx context.Context) (*k8s.Secret, error) {
 		}
 		secret.Data[k] = base64.StdEncoding.EncodeToString(value)
 	}
+	s.cached = secret
 	return secret, nil
 }
@@ -49,4 +49,30 @@ data:
   secret: aGVsbG8gd29ybGQ=
 `))
 	})
+	It("reads the secret values only once", func() {
+		counter := 0
+		secretDeployer = &SecretApplier{
+			Namespace: "banana",
+			Name:      "banana",
+			Secrets: Secrets{
+				"secret": countingSecretValue(func() { counter++ }),
+			},
+		}
+		_, err = secretDeployer.secret(context.Background())
+		Expect(err).To(BeNil())
+		_, err = secretDeployer.checksum(context.Background())
+		Expect(err).To(BeNil())
+		Expect(counter).To(Equal(1))
+	})
 })
+
+type countingSecretValue func()
+
+func (c countingSecretValue) Value(ctx context.Context) ([]byte, error) {
+	c()
+	return []byte("hello world"), nil
+}
+
+func (c countingSecretValue) Validate(ctx context.Context) error {
+	return nil
+}
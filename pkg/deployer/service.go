@@ -16,6 +16,7 @@ import (
 
 type ServiceDeployer struct {
 	Context      k8s.Context
+	Overlays     k8s.Overlays
 	Namespace    k8s.NamespaceName
 	Name         k8s.MetadataName
 	Ports        []Port
@@ -44,8 +45,9 @@ func (t *ServiceDeployer) Validate(ctx context.Context) error {
 
 func (s *ServiceDeployer) Applier() (world.Applier, error) {
 	return &k8s.ServiceApplier{
-		Context: s.Context,
-		Service: s.service(),
+		Context:  s.Context,
+		Overlays: s.Overlays,
+		Service:  s.service(),
 	}, nil
 }
 
@@ -0,0 +1,75 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package deployer
+
+import (
+	"context"
+
+	"github.com/bborbe/world/pkg/k8s"
+	"github.com/bborbe/world/pkg/validation"
+	"github.com/bborbe/world/pkg/world"
+)
+
+// ServiceMonitorDeployer lets prometheus scrape the port of the Service with the same name.
+type ServiceMonitorDeployer struct {
+	Context      k8s.Context
+	Overlays     k8s.Overlays
+	Namespace    k8s.NamespaceName
+	Name         k8s.MetadataName
+	Port         k8s.PortName
+	Path         string
+	Interval     string
+	Requirements []world.Configuration
+}
+
+func (s *ServiceMonitorDeployer) Validate(ctx context.Context) error {
+	return validation.Validate(
+		ctx,
+		s.Context,
+		s.Namespace,
+		s.Name,
+		s.Port,
+	)
+}
+
+func (s *ServiceMonitorDeployer) Applier() (world.Applier, error) {
+	return &k8s.ServiceMonitorApplier{
+		Context:        s.Context,
+		Overlays:       s.Overlays,
+		ServiceMonitor: s.serviceMonitor(),
+	}, nil
+}
+
+func (s *ServiceMonitorDeployer) Children(ctx context.Context) (world.Configurations, error) {
+	return s.Requirements, nil
+}
+
+func (s *ServiceMonitorDeployer) serviceMonitor() k8s.ServiceMonitor {
+	return k8s.ServiceMonitor{
+		ApiVersion: "monitoring.coreos.com/v1",
+		Kind:       "ServiceMonitor",
+		Metadata: k8s.Metadata{
+			Namespace: s.Namespace,
+			Name:      s.Name,
+			Labels: k8s.Labels{
+				"app": s.Name.String(),
+			},
+		},
+		Spec: k8s.ServiceMonitorSpec{
+			Selector: k8s.LabelSelector{
+				MatchLabels: k8s.Labels{
+					"app": s.Name.String(),
+				},
+			},
+			Endpoints: []k8s.MonitorEndpoint{
+				{
+					Port:     s.Port,
+					Path:     s.Path,
+					Interval: s.Interval,
+				},
+			},
+		},
+	}
+}
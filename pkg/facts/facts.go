@@ -0,0 +1,260 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package facts
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/golang/glog"
+	"github.com/pkg/errors"
+
+	"github.com/bborbe/world/pkg/ssh"
+)
+
+const sectionPrefix = "### "
+
+// gatherCommand prints all facts in sections, so only one command per host is required.
+const gatherCommand = `echo "### os-release"; cat /etc/os-release; ` +
+	`echo "### kernel"; uname -r; ` +
+	`echo "### machine"; uname -m; ` +
+	`echo "### architecture"; dpkg --print-architecture 2>/dev/null; ` +
+	`echo "### packages"; dpkg-query -W 2>/dev/null; ` +
+	`echo "### units"; systemctl list-unit-files --state=enabled --no-legend --no-pager 2>/dev/null; ` +
+	`echo "### interfaces"; ip -o addr show 2>/dev/null; ` +
+	`true`
+
+type OSRelease struct {
+	ID              string
+	VersionID       string
+	VersionCodename string
+	PrettyName      string
+}
+
+type Interface struct {
+	Name      string
+	Addresses []string
+}
+
+type Facts struct {
+	OS           OSRelease
+	Kernel       string
+	Machine      string
+	Architecture string
+	Packages     map[string]string
+	EnabledUnits []string
+	Interfaces   []Interface
+}
+
+// HasPackage returns true if the package is installed.
+func (f *Facts) HasPackage(name string) bool {
+	_, ok := f.Packages[name]
+	return ok
+}
+
+// UnitEnabled returns true if the systemd unit is enabled. The suffix .service is optional.
+func (f *Facts) UnitEnabled(name string) bool {
+	if !strings.Contains(name, ".") {
+		name = name + ".service"
+	}
+	for _, unit := range f.EnabledUnits {
+		if unit == name {
+			return true
+		}
+	}
+	return false
+}
+
+// Interface returns the network interface with the given name.
+func (f *Facts) Interface(name string) (Interface, bool) {
+	for _, i := range f.Interfaces {
+		if i.Name == name {
+			return i, true
+		}
+	}
+	return Interface{}, false
+}
+
+// Gather collects all facts of the host.
+func Gather(ctx context.Context, executor ssh.Executor) (*Facts, error) {
+	stdout, err := executor.RunCommandStdout(ctx, gatherCommand)
+	if err != nil {
+		return nil, errors.Wrap(err, "gather facts failed")
+	}
+	return Parse(stdout)
+}
+
+// Parse the output of the gather command.
+func Parse(content []byte) (*Facts, error) {
+	facts := &Facts{
+		Packages: make(map[string]string),
+	}
+	var section string
+	scanner := bufio.NewScanner(bytes.NewReader(content))
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if strings.HasPrefix(line, sectionPrefix) {
+			section = strings.TrimPrefix(line, sectionPrefix)
+			continue
+		}
+		if line == "" {
+			continue
+		}
+		switch section {
+		case "os-release":
+			parseOSRelease(&facts.OS, line)
+		case "kernel":
+			facts.Kernel = line
+		case "machine":
+			facts.Machine = line
+		case "architecture":
+			facts.Architecture = line
+		case "packages":
+			parts := strings.Fields(line)
+			if len(parts) == 2 {
+				facts.Packages[parts[0]] = parts[1]
+			}
+		case "units":
+			parts := strings.Fields(line)
+			if len(parts) > 0 {
+				facts.EnabledUnits = append(facts.EnabledUnits, parts[0])
+			}
+		case "interfaces":
+			parseInterface(facts, line)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, errors.Wrap(err, "scan facts failed")
+	}
+	sort.Strings(facts.EnabledUnits)
+	if facts.Architecture == "" {
+		facts.Architecture = architectureOfMachine(facts.Machine)
+	}
+	return facts, nil
+}
+
+func parseOSRelease(osRelease *OSRelease, line string) {
+	parts := strings.SplitN(line, "=", 2)
+	if len(parts) != 2 {
+		return
+	}
+	value := strings.Trim(parts[1], `"'`)
+	switch parts[0] {
+	case "ID":
+		osRelease.ID = value
+	case "VERSION_ID":
+		osRelease.VersionID = value
+	case "VERSION_CODENAME":
+		osRelease.VersionCodename = value
+	case "PRETTY_NAME":
+		osRelease.PrettyName = value
+	}
+}
+
+// parseInterface parses a line of ip -o addr show like
+// 2: eth0    inet 192.168.178.2/24 brd 192.168.178.255 scope global eth0
+func parseInterface(facts *Facts, line string) {
+	parts := strings.Fields(line)
+	if len(parts) < 4 {
+		return
+	}
+	name := strings.TrimSuffix(parts[1], ":")
+	for i := range facts.Interfaces {
+		if facts.Interfaces[i].Name == name {
+			facts.Interfaces[i].Addresses = append(facts.Interfaces[i].Addresses, parts[3])
+			return
+		}
+	}
+	facts.Interfaces = append(facts.Interfaces, Interface{
+		Name:      name,
+		Addresses: []string{parts[3]},
+	})
+}
+
+// architectureOfMachine maps uname -m to the debian architecture name.
+func architectureOfMachine(machine string) string {
+	switch machine {
+	case "x86_64":
+		return "amd64"
+	case "aarch64":
+		return "arm64"
+	case "armv7l", "armv6l":
+		return "armhf"
+	default:
+		return machine
+	}
+}
+
+// DefaultCache is shared by all configurations of a run.
+var DefaultCache = &Cache{}
+
+// Get returns the facts of the host from the DefaultCache.
+func Get(ctx context.Context, executor ssh.Executor) (*Facts, error) {
+	return DefaultCache.Get(ctx, executor)
+}
+
+// Cache gathers the facts only once per host.
+// Executors with Key are cached by key, so all executors of the same host share the facts.
+type Cache struct {
+	mux   sync.Mutex
+	facts map[interface{}]*Facts
+}
+
+type keyed interface {
+	Key(ctx context.Context) (string, error)
+}
+
+func cacheKey(ctx context.Context, executor ssh.Executor) (interface{}, error) {
+	if k, ok := executor.(keyed); ok {
+		key, err := k.Key(ctx)
+		if err != nil {
+			return nil, errors.Wrap(err, "get key of executor failed")
+		}
+		return key, nil
+	}
+	return executor, nil
+}
+
+func (c *Cache) Get(ctx context.Context, executor ssh.Executor) (*Facts, error) {
+	key, err := cacheKey(ctx, executor)
+	if err != nil {
+		return nil, err
+	}
+
+	c.mux.Lock()
+	defer c.mux.Unlock()
+
+	if facts, ok := c.facts[key]; ok {
+		return facts, nil
+	}
+	glog.V(2).Infof("gather facts of %v ...", key)
+	facts, err := Gather(ctx, executor)
+	if err != nil {
+		return nil, err
+	}
+	if c.facts == nil {
+		c.facts = make(map[interface{}]*Facts)
+	}
+	c.facts[key] = facts
+	glog.V(2).Infof("gather facts finished: %s %s %s", facts.OS.ID, facts.OS.VersionCodename, facts.Architecture)
+	return facts, nil
+}
+
+// Invalidate removes the cached facts of the host, so they are gathered again on next Get.
+func (c *Cache) Invalidate(ctx context.Context, executor ssh.Executor) error {
+	key, err := cacheKey(ctx, executor)
+	if err != nil {
+		return err
+	}
+	c.mux.Lock()
+	defer c.mux.Unlock()
+	delete(c.facts, key)
+	return nil
+}
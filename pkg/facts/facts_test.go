@@ -0,0 +1,129 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package facts_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/bborbe/world/pkg/facts"
+	"github.com/bborbe/world/pkg/ssh/sshtest"
+)
+
+const output = `### os-release
+PRETTY_NAME="Ubuntu 22.04.3 LTS"
+NAME="Ubuntu"
+VERSION_ID="22.04"
+VERSION_CODENAME=jammy
+ID=ubuntu
+### kernel
+5.15.0-91-generic
+### machine
+aarch64
+### architecture
+### packages
+curl	7.81.0-1ubuntu1.15
+openvpn	2.5.5-1ubuntu3.1
+### units
+ssh.service                            enabled enabled
+cron.service                           enabled enabled
+### interfaces
+1: lo    inet 127.0.0.1/8 scope host lo\       valid_lft forever preferred_lft forever
+2: eth0    inet 192.168.178.8/24 brd 192.168.178.255 scope global eth0\       valid_lft forever preferred_lft forever
+2: eth0    inet6 fe80::1/64 scope link \       valid_lft forever preferred_lft forever
+`
+
+var _ = Describe("Facts", func() {
+	var f *facts.Facts
+	var err error
+	Context("Parse", func() {
+		BeforeEach(func() {
+			f, err = facts.Parse([]byte(output))
+		})
+		It("returns no error", func() {
+			Expect(err).To(BeNil())
+		})
+		It("parses os release", func() {
+			Expect(f.OS).To(Equal(facts.OSRelease{
+				ID:              "ubuntu",
+				VersionID:       "22.04",
+				VersionCodename: "jammy",
+				PrettyName:      "Ubuntu 22.04.3 LTS",
+			}))
+		})
+		It("parses kernel", func() {
+			Expect(f.Kernel).To(Equal("5.15.0-91-generic"))
+		})
+		It("falls back to architecture of machine", func() {
+			Expect(f.Machine).To(Equal("aarch64"))
+			Expect(f.Architecture).To(Equal("arm64"))
+		})
+		It("parses packages", func() {
+			Expect(f.Packages).To(HaveLen(2))
+			Expect(f.HasPackage("openvpn")).To(BeTrue())
+			Expect(f.HasPackage("nginx")).To(BeFalse())
+			Expect(f.Packages["curl"]).To(Equal("7.81.0-1ubuntu1.15"))
+		})
+		It("parses enabled units", func() {
+			Expect(f.EnabledUnits).To(Equal([]string{"cron.service", "ssh.service"}))
+			Expect(f.UnitEnabled("ssh")).To(BeTrue())
+			Expect(f.UnitEnabled("nginx")).To(BeFalse())
+		})
+		It("parses interfaces", func() {
+			Expect(f.Interfaces).To(HaveLen(2))
+			eth0, ok := f.Interface("eth0")
+			Expect(ok).To(BeTrue())
+			Expect(eth0.Addresses).To(Equal([]string{"192.168.178.8/24", "fe80::1/64"}))
+		})
+	})
+	Context("Cache", func() {
+		var cache *facts.Cache
+		var recorder *sshtest.Recorder
+		BeforeEach(func() {
+			cache = &facts.Cache{}
+			recorder = &sshtest.Recorder{
+				Responses: []sshtest.Response{
+					{
+						Pattern: "os-release",
+						Stdout:  []byte(output),
+					},
+				},
+			}
+		})
+		It("gathers facts only once per host", func() {
+			ctx := context.Background()
+			_, err = cache.Get(ctx, recorder)
+			Expect(err).To(BeNil())
+			f, err = cache.Get(ctx, recorder)
+			Expect(err).To(BeNil())
+			Expect(f.OS.ID).To(Equal("ubuntu"))
+			Expect(recorder.Records()).To(HaveLen(1))
+		})
+		It("shares facts between executors of the same host", func() {
+			ctx := context.Background()
+			recorder.Host = "bborbe@10.0.0.1:22"
+			other := &sshtest.Recorder{
+				Host: "bborbe@10.0.0.1:22",
+			}
+			_, err = cache.Get(ctx, recorder)
+			Expect(err).To(BeNil())
+			f, err = cache.Get(ctx, other)
+			Expect(err).To(BeNil())
+			Expect(f.OS.ID).To(Equal("ubuntu"))
+			Expect(other.Records()).To(HaveLen(0))
+		})
+		It("gathers again after invalidate", func() {
+			ctx := context.Background()
+			_, err = cache.Get(ctx, recorder)
+			Expect(err).To(BeNil())
+			Expect(cache.Invalidate(ctx, recorder)).To(BeNil())
+			_, err = cache.Get(ctx, recorder)
+			Expect(err).To(BeNil())
+			Expect(recorder.Records()).To(HaveLen(2))
+		})
+	})
+})
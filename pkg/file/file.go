@@ -47,6 +47,10 @@ func (f User) Validate(ctx context.Context) error {
 	return nil
 }
 
+func (f User) String() string {
+	return string(f)
+}
+
 type Group string
 
 func (f Group) Validate(ctx context.Context) error {
@@ -56,6 +60,10 @@ func (f Group) Validate(ctx context.Context) error {
 	return nil
 }
 
+func (f Group) String() string {
+	return string(f)
+}
+
 type Perm uint32
 
 func (f Perm) Validate(ctx context.Context) error {
@@ -4,4 +4,79 @@
 
 package k8s
 
+import (
+	"github.com/pkg/errors"
+	yaml "gopkg.in/yaml.v2"
+)
+
 type Annotations map[string]string
+
+// SetAnnotation sets the annotation in the metadata of the yaml object.
+func SetAnnotation(data []byte, key string, value string) ([]byte, error) {
+	var object yaml.MapSlice
+	if err := yaml.Unmarshal(data, &object); err != nil {
+		return nil, errors.Wrap(err, "parse object failed")
+	}
+	metadata := mapSliceGet(object, "metadata")
+	annotations := mapSliceGet(metadata, "annotations")
+	annotations = mapSliceSet(annotations, key, value)
+	metadata = mapSliceSet(metadata, "annotations", annotations)
+	object = mapSliceSet(object, "metadata", metadata)
+	result, err := yaml.Marshal(object)
+	if err != nil {
+		return nil, errors.Wrap(err, "encode object failed")
+	}
+	return result, nil
+}
+
+func mapSliceGet(slice yaml.MapSlice, key string) yaml.MapSlice {
+	for _, item := range slice {
+		if item.Key == key {
+			if value, ok := item.Value.(yaml.MapSlice); ok {
+				return value
+			}
+		}
+	}
+	return nil
+}
+
+// mapSliceSet returns a copy of the slice with the key set.
+func mapSliceSet(slice yaml.MapSlice, key string, value interface{}) yaml.MapSlice {
+	result := append(yaml.MapSlice{}, slice...)
+	for i, item := range result {
+		if item.Key == key {
+			result[i].Value = value
+			return result
+		}
+	}
+	return append(result, yaml.MapItem{Key: key, Value: value})
+}
+
+func mapSliceValue(slice yaml.MapSlice, key string) interface{} {
+	for _, item := range slice {
+		if item.Key == key {
+			return item.Value
+		}
+	}
+	return nil
+}
+
+func mapSliceHas(slice yaml.MapSlice, key string) bool {
+	for _, item := range slice {
+		if item.Key == key {
+			return true
+		}
+	}
+	return false
+}
+
+// mapSliceDelete returns a copy of the slice without the key.
+func mapSliceDelete(slice yaml.MapSlice, key string) yaml.MapSlice {
+	result := yaml.MapSlice{}
+	for _, item := range slice {
+		if item.Key != key {
+			result = append(result, item)
+		}
+	}
+	return result
+}
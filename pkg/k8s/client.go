@@ -0,0 +1,342 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package k8s
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang/glog"
+	"github.com/pkg/errors"
+	"gopkg.in/yaml.v2"
+)
+
+// FieldManager used for server-side apply.
+const FieldManager = "world"
+
+// ObjectReference identifies a single object.
+type ObjectReference struct {
+	ApiVersion ApiVersion    `json:"apiVersion"`
+	Kind       Kind          `json:"kind"`
+	Namespace  NamespaceName `json:"namespace,omitempty"`
+	Name       MetadataName  `json:"name"`
+}
+
+func (o ObjectReference) String() string {
+	if o.Namespace == "" {
+		return fmt.Sprintf("%s/%s", o.Kind, o.Name)
+	}
+	return fmt.Sprintf("%s/%s in %s", o.Kind, o.Name, o.Namespace)
+}
+
+// ParseObjectReference reads apiVersion, kind, namespace and name of the yaml or json object.
+func ParseObjectReference(data []byte) (*ObjectReference, error) {
+	var header struct {
+		ApiVersion ApiVersion `yaml:"apiVersion"`
+		Kind       Kind       `yaml:"kind"`
+		Metadata   struct {
+			Name      MetadataName  `yaml:"name"`
+			Namespace NamespaceName `yaml:"namespace"`
+		} `yaml:"metadata"`
+	}
+	if err := yaml.Unmarshal(data, &header); err != nil {
+		return nil, errors.Wrap(err, "parse object failed")
+	}
+	if header.ApiVersion == "" || header.Kind == "" || header.Metadata.Name == "" {
+		return nil, errors.New("apiVersion, kind or metadata.name missing")
+	}
+	return &ObjectReference{
+		ApiVersion: header.ApiVersion,
+		Kind:       header.Kind,
+		Namespace:  header.Metadata.Namespace,
+		Name:       header.Metadata.Name,
+	}, nil
+}
+
+// StatusError is a failure returned by the api server.
+type StatusError struct {
+	Code    int    `json:"code"`
+	Reason  string `json:"reason"`
+	Message string `json:"message"`
+}
+
+func (s *StatusError) Error() string {
+	return fmt.Sprintf("%s (%d): %s", s.Reason, s.Code, s.Message)
+}
+
+// IsNotFound returns true if the api server reported the object missing.
+func IsNotFound(err error) bool {
+	statusError, ok := errors.Cause(err).(*StatusError)
+	return ok && statusError.Code == http.StatusNotFound
+}
+
+type apiResource struct {
+	Name       string `json:"name"`
+	Kind       string `json:"kind"`
+	Namespaced bool   `json:"namespaced"`
+}
+
+// Client talks to the api server of one kubeconfig context.
+type Client struct {
+	Server     string
+	Namespace  string
+	HTTPClient *http.Client
+	Token      string
+	Username   string
+	Password   string
+
+	mux       sync.Mutex
+	resources map[ApiVersion][]apiResource
+}
+
+// NewClient creates a client for the context of the kubeconfig.
+func NewClient(context Context, paths ...string) (*Client, error) {
+	entry, err := LoadKubeconfigEntry(context, paths...)
+	if err != nil {
+		return nil, err
+	}
+	tlsConfig := &tls.Config{
+		InsecureSkipVerify: entry.Cluster.InsecureSkipTLSVerify, // #nosec G402 configured in kubeconfig
+	}
+	ca, err := readData(entry.Cluster.CertificateAuthorityData, entry.Cluster.CertificateAuthority)
+	if err != nil {
+		return nil, errors.Wrap(err, "read certificate authority failed")
+	}
+	if len(ca) > 0 {
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(ca) {
+			return nil, errors.New("parse certificate authority failed")
+		}
+		tlsConfig.RootCAs = pool
+	}
+	cert, err := readData(entry.User.ClientCertificateData, entry.User.ClientCertificate)
+	if err != nil {
+		return nil, errors.Wrap(err, "read client certificate failed")
+	}
+	key, err := readData(entry.User.ClientKeyData, entry.User.ClientKey)
+	if err != nil {
+		return nil, errors.Wrap(err, "read client key failed")
+	}
+	if len(cert) > 0 {
+		certificate, err := tls.X509KeyPair(cert, key)
+		if err != nil {
+			return nil, errors.Wrap(err, "parse client certificate failed")
+		}
+		tlsConfig.Certificates = []tls.Certificate{certificate}
+	}
+	token := entry.User.Token
+	if token == "" && entry.User.TokenFile != "" {
+		content, err := ioutil.ReadFile(entry.User.TokenFile)
+		if err != nil {
+			return nil, errors.Wrap(err, "read token file failed")
+		}
+		token = strings.TrimSpace(string(content))
+	}
+	return &Client{
+		Server:    strings.TrimSuffix(entry.Cluster.Server, "/"),
+		Namespace: entry.Namespace,
+		HTTPClient: &http.Client{
+			Timeout: 60 * time.Second,
+			Transport: &http.Transport{
+				Proxy:           http.ProxyFromEnvironment,
+				TLSClientConfig: tlsConfig,
+			},
+		},
+		Token:    token,
+		Username: entry.User.Username,
+		Password: entry.User.Password,
+	}, nil
+}
+
+func readData(data string, path string) ([]byte, error) {
+	if data != "" {
+		return base64.StdEncoding.DecodeString(data)
+	}
+	if path != "" {
+		return ioutil.ReadFile(path)
+	}
+	return nil, nil
+}
+
+// Apply the yaml or json object with server-side apply.
+func (c *Client) Apply(ctx context.Context, data []byte) error {
+	ref, err := ParseObjectReference(data)
+	if err != nil {
+		return err
+	}
+	path, err := c.objectPath(ctx, *ref)
+	if err != nil {
+		return err
+	}
+	query := url.Values{}
+	query.Set("fieldManager", FieldManager)
+	query.Set("force", "true")
+	glog.V(3).Infof("apply %s", ref)
+	_, err = c.do(ctx, http.MethodPatch, path+"?"+query.Encode(), "application/apply-patch+yaml", data)
+	return errors.Wrapf(err, "apply %s failed", ref)
+}
+
+// Get returns the live object as json.
+func (c *Client) Get(ctx context.Context, ref ObjectReference) ([]byte, error) {
+	path, err := c.objectPath(ctx, ref)
+	if err != nil {
+		return nil, err
+	}
+	return c.do(ctx, http.MethodGet, path, "", nil)
+}
+
+// List returns all objects of the kind in the namespace matching the query as json.
+func (c *Client) List(ctx context.Context, apiVersion ApiVersion, kind Kind, namespace NamespaceName, query url.Values) ([]byte, error) {
+	path, err := c.collectionPath(ctx, apiVersion, kind, namespace)
+	if err != nil {
+		return nil, err
+	}
+	if len(query) > 0 {
+		path = path + "?" + query.Encode()
+	}
+	return c.do(ctx, http.MethodGet, path, "", nil)
+}
+
+// Logs returns the last lines of the log of the container.
+func (c *Client) Logs(ctx context.Context, namespace NamespaceName, pod string, container string, previous bool, tailLines int) ([]byte, error) {
+	query := url.Values{}
+	query.Set("container", container)
+	query.Set("tailLines", strconv.Itoa(tailLines))
+	if previous {
+		query.Set("previous", "true")
+	}
+	return c.do(ctx, http.MethodGet, fmt.Sprintf("/api/v1/namespaces/%s/pods/%s/log?%s", namespace, pod, query.Encode()), "", nil)
+}
+
+// Delete the object, dependents like the pods of a Job are deleted in background as with kubectl.
+func (c *Client) Delete(ctx context.Context, ref ObjectReference) error {
+	path, err := c.objectPath(ctx, ref)
+	if err != nil {
+		return err
+	}
+	_, err = c.do(ctx, http.MethodDelete, path, "application/json", []byte(`{"apiVersion":"v1","kind":"DeleteOptions","propagationPolicy":"Background"}`))
+	return err
+}
+
+// objectPath returns the url path of the object, resolving the resource name by discovery.
+func (c *Client) objectPath(ctx context.Context, ref ObjectReference) (string, error) {
+	collection, err := c.collectionPath(ctx, ref.ApiVersion, ref.Kind, ref.Namespace)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%s/%s", collection, ref.Name), nil
+}
+
+// collectionPath returns the url path of all objects of the kind in the namespace.
+func (c *Client) collectionPath(ctx context.Context, apiVersion ApiVersion, kind Kind, namespace NamespaceName) (string, error) {
+	resource, err := c.resource(ctx, apiVersion, kind)
+	if err != nil {
+		return "", err
+	}
+	prefix := fmt.Sprintf("/apis/%s", apiVersion)
+	if !strings.Contains(string(apiVersion), "/") {
+		prefix = fmt.Sprintf("/api/%s", apiVersion)
+	}
+	if !resource.Namespaced {
+		return fmt.Sprintf("%s/%s", prefix, resource.Name), nil
+	}
+	if namespace == "" {
+		namespace = NamespaceName(c.Namespace)
+	}
+	if namespace == "" {
+		namespace = "default"
+	}
+	return fmt.Sprintf("%s/namespaces/%s/%s", prefix, namespace, resource.Name), nil
+}
+
+func (c *Client) resource(ctx context.Context, apiVersion ApiVersion, kind Kind) (*apiResource, error) {
+	c.mux.Lock()
+	defer c.mux.Unlock()
+	resources, ok := c.resources[apiVersion]
+	if !ok {
+		prefix := fmt.Sprintf("/apis/%s", apiVersion)
+		if !strings.Contains(string(apiVersion), "/") {
+			prefix = fmt.Sprintf("/api/%s", apiVersion)
+		}
+		content, err := c.do(ctx, http.MethodGet, prefix, "", nil)
+		if err != nil {
+			return nil, errors.Wrapf(err, "discover %s failed", apiVersion)
+		}
+		var list struct {
+			Resources []apiResource `json:"resources"`
+		}
+		if err := json.Unmarshal(content, &list); err != nil {
+			return nil, errors.Wrapf(err, "parse resources of %s failed", apiVersion)
+		}
+		for _, resource := range list.Resources {
+			if !strings.Contains(resource.Name, "/") {
+				resources = append(resources, resource)
+			}
+		}
+		if c.resources == nil {
+			c.resources = map[ApiVersion][]apiResource{}
+		}
+		c.resources[apiVersion] = resources
+	}
+	for _, resource := range resources {
+		if resource.Kind == string(kind) {
+			return &resource, nil
+		}
+	}
+	// discover again next time, the kind may be added by a CRD
+	delete(c.resources, apiVersion)
+	return nil, &StatusError{Code: http.StatusNotFound, Reason: "NotFound", Message: fmt.Sprintf("kind %s not found in %s", kind, apiVersion)}
+}
+
+func (c *Client) do(ctx context.Context, method string, path string, contentType string, body []byte) ([]byte, error) {
+	var reader io.Reader
+	if body != nil {
+		reader = bytes.NewReader(body)
+	}
+	req, err := http.NewRequestWithContext(ctx, method, c.Server+path, reader)
+	if err != nil {
+		return nil, errors.Wrap(err, "create request failed")
+	}
+	req.Header.Set("Accept", "application/json")
+	if contentType != "" {
+		req.Header.Set("Content-Type", contentType)
+	}
+	if c.Token != "" {
+		req.Header.Set("Authorization", "Bearer "+c.Token)
+	} else if c.Username != "" {
+		req.SetBasicAuth(c.Username, c.Password)
+	}
+	resp, err := c.HTTPClient.Do(req)
+	if err != nil {
+		return nil, errors.Wrapf(err, "%s %s failed", method, path)
+	}
+	defer resp.Body.Close()
+	content, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, errors.Wrap(err, "read response failed")
+	}
+	if resp.StatusCode/100 != 2 {
+		statusError := &StatusError{}
+		if err := json.Unmarshal(content, statusError); err != nil || statusError.Message == "" {
+			statusError.Message = strings.TrimSpace(string(content))
+		}
+		statusError.Code = resp.StatusCode
+		return nil, statusError
+	}
+	return content, nil
+}
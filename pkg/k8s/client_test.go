@@ -0,0 +1,137 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package k8s_test
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/pkg/errors"
+
+	"github.com/bborbe/world/pkg/k8s"
+)
+
+var _ = Describe("Client", func() {
+	var ctx context.Context
+	var server *httptest.Server
+	var requests []*http.Request
+	var bodies []string
+	var client *k8s.Client
+	var kubeconfigPath string
+	BeforeEach(func() {
+		ctx = context.Background()
+		requests = nil
+		bodies = nil
+		server = httptest.NewServer(http.HandlerFunc(func(resp http.ResponseWriter, req *http.Request) {
+			body, _ := ioutil.ReadAll(req.Body)
+			requests = append(requests, req)
+			bodies = append(bodies, string(body))
+			switch req.URL.Path {
+			case "/apis/apps/v1":
+				_, _ = resp.Write([]byte(`{"resources":[{"name":"deployments","kind":"Deployment","namespaced":true},{"name":"deployments/status","kind":"Deployment","namespaced":true}]}`))
+			case "/api/v1":
+				_, _ = resp.Write([]byte(`{"resources":[{"name":"namespaces","kind":"Namespace","namespaced":false}]}`))
+			case "/apis/apps/v1/namespaces/default/deployments/missing":
+				resp.WriteHeader(http.StatusNotFound)
+				_, _ = resp.Write([]byte(`{"kind":"Status","reason":"NotFound","message":"deployments.apps \"missing\" not found","code":404}`))
+			default:
+				_, _ = resp.Write([]byte(`{}`))
+			}
+		}))
+		dir, err := ioutil.TempDir("", "kubeconfig")
+		Expect(err).To(BeNil())
+		kubeconfigPath = filepath.Join(dir, "config")
+		Expect(ioutil.WriteFile(kubeconfigPath, []byte(`apiVersion: v1
+kind: Config
+current-context: other
+clusters:
+- name: test
+  cluster:
+    server: `+server.URL+`
+contexts:
+- name: test
+  context:
+    cluster: test
+    user: test
+    namespace: world
+- name: plugin
+  context:
+    cluster: test
+    user: plugin
+users:
+- name: test
+  user:
+    token: secret-token
+- name: plugin
+  user:
+    exec:
+      command: aws
+`), 0600)).To(BeNil())
+		client, err = k8s.NewClient("test", kubeconfigPath)
+		Expect(err).To(BeNil())
+	})
+	AfterEach(func() {
+		server.Close()
+		_ = os.RemoveAll(filepath.Dir(kubeconfigPath))
+	})
+	It("reads namespace of context", func() {
+		Expect(client.Namespace).To(Equal("world"))
+	})
+	It("returns unsupported for auth plugins", func() {
+		_, err := k8s.NewClient("plugin", kubeconfigPath)
+		Expect(errors.Cause(err)).To(Equal(k8s.ErrUnsupportedKubeconfig))
+	})
+	It("returns error for unknown context", func() {
+		_, err := k8s.NewClient("unknown", kubeconfigPath)
+		Expect(err).NotTo(BeNil())
+	})
+	It("applies object server-side", func() {
+		err := client.Apply(ctx, []byte(`apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: banana
+  namespace: fruits
+`))
+		Expect(err).To(BeNil())
+		Expect(requests).To(HaveLen(2))
+		Expect(requests[1].Method).To(Equal(http.MethodPatch))
+		Expect(requests[1].URL.Path).To(Equal("/apis/apps/v1/namespaces/fruits/deployments/banana"))
+		Expect(requests[1].URL.Query().Get("fieldManager")).To(Equal("world"))
+		Expect(requests[1].Header.Get("Content-Type")).To(Equal("application/apply-patch+yaml"))
+		Expect(requests[1].Header.Get("Authorization")).To(Equal("Bearer secret-token"))
+		Expect(bodies[1]).To(ContainSubstring("name: banana"))
+	})
+	It("applies cluster scoped object", func() {
+		err := client.Apply(ctx, []byte(`{"apiVersion":"v1","kind":"Namespace","metadata":{"name":"fruits"}}`))
+		Expect(err).To(BeNil())
+		Expect(requests[1].URL.Path).To(Equal("/api/v1/namespaces/fruits"))
+	})
+	It("discovers resources only once", func() {
+		data := []byte("apiVersion: apps/v1\nkind: Deployment\nmetadata:\n  name: banana\n")
+		Expect(client.Apply(ctx, data)).To(BeNil())
+		Expect(client.Apply(ctx, data)).To(BeNil())
+		Expect(requests).To(HaveLen(3))
+		Expect(requests[2].URL.Path).To(Equal("/apis/apps/v1/namespaces/world/deployments/banana"))
+	})
+	It("returns structured not found", func() {
+		_, err := client.Get(ctx, k8s.ObjectReference{ApiVersion: "apps/v1", Kind: "Deployment", Namespace: "default", Name: "missing"})
+		Expect(err).NotTo(BeNil())
+		Expect(k8s.IsNotFound(err)).To(BeTrue())
+	})
+	It("discovers again if kind is unknown", func() {
+		ref := k8s.ObjectReference{ApiVersion: "apps/v1", Kind: "Backup", Namespace: "default", Name: "daily"}
+		_, err := client.Get(ctx, ref)
+		Expect(k8s.IsNotFound(err)).To(BeTrue())
+		_, err = client.Get(ctx, ref)
+		Expect(k8s.IsNotFound(err)).To(BeTrue())
+		Expect(requests).To(HaveLen(2))
+	})
+})
@@ -0,0 +1,32 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package k8s
+
+import (
+	"context"
+
+	"github.com/bborbe/world/pkg/validation"
+)
+
+// Cluster holds the settings of the Kubernetes cluster behind a context.
+type Cluster struct {
+	Context Context
+	// Version of the cluster. Without version the oldest supported apis are used.
+	Version  Version
+	Overlays Overlays
+}
+
+func (c Cluster) Validate(ctx context.Context) error {
+	if c.Version != "" {
+		if err := c.Version.Validate(ctx); err != nil {
+			return err
+		}
+	}
+	return validation.Validate(
+		ctx,
+		c.Context,
+		c.Overlays,
+	)
+}
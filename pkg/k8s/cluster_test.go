@@ -0,0 +1,37 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package k8s_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/bborbe/world/pkg/k8s"
+)
+
+var _ = Describe("Cluster", func() {
+	It("keeps extensions/v1beta1 ingress without version", func() {
+		configuration := k8s.BuildIngressConfigurationWithCertManager(k8s.Cluster{Context: "banana"}, "ns", "name", "service", "http", "/", "example.com")
+		Expect(configuration.Ingress.ApiVersion).To(Equal(k8s.ApiVersion("extensions/v1beta1")))
+		Expect(configuration.Ingress.Metadata.Annotations).To(HaveKeyWithValue("kubernetes.io/ingress.class", "nginx"))
+	})
+	It("rejects invalid version", func() {
+		cluster := k8s.Cluster{Context: "banana", Version: "latest"}
+		Expect(cluster.Validate(context.Background())).NotTo(BeNil())
+	})
+	It("builds ingress for the cluster", func() {
+		cluster := k8s.Cluster{
+			Context:  "banana",
+			Version:  "1.16",
+			Overlays: k8s.Overlays{{NamePrefix: "dev-"}},
+		}
+		configuration := k8s.BuildIngressConfigurationWithCertManager(cluster, "ns", "name", "service", "http", "/", "example.com")
+		Expect(configuration.Context).To(Equal(cluster.Context))
+		Expect(configuration.Overlays).To(Equal(cluster.Overlays))
+		Expect(configuration.Ingress.ApiVersion).To(Equal(k8s.ApiVersion("networking.k8s.io/v1beta1")))
+	})
+})
@@ -16,6 +16,7 @@ import (
 
 type ClusterRoleConfiguration struct {
 	Context      Context
+	Overlays     Overlays
 	ClusterRole  ClusterRole
 	Requirements []world.Configuration
 }
@@ -31,6 +32,7 @@ func (c *ClusterRoleConfiguration) Validate(ctx context.Context) error {
 func (c *ClusterRoleConfiguration) Applier() (world.Applier, error) {
 	return &ClusterRoleApplier{
 		Context:     c.Context,
+		Overlays:    c.Overlays,
 		ClusterRole: c.ClusterRole,
 	}, nil
 }
@@ -54,17 +56,28 @@ func (c ClusterRoleName) Validate(ctx context.Context) error {
 
 type ClusterRoleApplier struct {
 	Context     Context
+	Overlays    Overlays
 	ClusterRole ClusterRole
 }
 
+func (c *ClusterRoleApplier) KubernetesContext() Context {
+	return c.Context
+}
+
 func (c *ClusterRoleApplier) Satisfied(ctx context.Context) (bool, error) {
-	return false, nil
+	deployer := &Deployer{
+		Context:  c.Context,
+		Overlays: c.Overlays,
+		Data:     c.ClusterRole,
+	}
+	return deployer.Satisfied(ctx)
 }
 
 func (c *ClusterRoleApplier) Apply(ctx context.Context) error {
 	deployer := &Deployer{
-		Context: c.Context,
-		Data:    c.ClusterRole,
+		Context:  c.Context,
+		Overlays: c.Overlays,
+		Data:     c.ClusterRole,
 	}
 	return deployer.Apply(ctx)
 }
@@ -73,6 +86,9 @@ func (c *ClusterRoleApplier) Validate(ctx context.Context) error {
 	if c.Context == "" {
 		return errors.New("context missing")
 	}
+	if err := c.Overlays.Validate(ctx); err != nil {
+		return err
+	}
 	return c.ClusterRole.Validate(ctx)
 }
 
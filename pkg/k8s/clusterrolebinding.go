@@ -16,6 +16,7 @@ import (
 
 type ClusterRoleBindingConfiguration struct {
 	Context            Context
+	Overlays           Overlays
 	ClusterRoleBinding ClusterRoleBinding
 	Requirements       []world.Configuration
 }
@@ -31,6 +32,7 @@ func (c *ClusterRoleBindingConfiguration) Validate(ctx context.Context) error {
 func (c *ClusterRoleBindingConfiguration) Applier() (world.Applier, error) {
 	return &ClusterRoleBindingApplier{
 		Context:            c.Context,
+		Overlays:           c.Overlays,
 		ClusterRoleBinding: c.ClusterRoleBinding,
 	}, nil
 }
@@ -54,17 +56,28 @@ func (a ClusterRoleBindingName) Validate(ctx context.Context) error {
 
 type ClusterRoleBindingApplier struct {
 	Context            Context
+	Overlays           Overlays
 	ClusterRoleBinding ClusterRoleBinding
 }
 
+func (s *ClusterRoleBindingApplier) KubernetesContext() Context {
+	return s.Context
+}
+
 func (s *ClusterRoleBindingApplier) Satisfied(ctx context.Context) (bool, error) {
-	return false, nil
+	deployer := &Deployer{
+		Context:  s.Context,
+		Overlays: s.Overlays,
+		Data:     s.ClusterRoleBinding,
+	}
+	return deployer.Satisfied(ctx)
 }
 
 func (s *ClusterRoleBindingApplier) Apply(ctx context.Context) error {
 	deployer := &Deployer{
-		Context: s.Context,
-		Data:    s.ClusterRoleBinding,
+		Context:  s.Context,
+		Overlays: s.Overlays,
+		Data:     s.ClusterRoleBinding,
 	}
 	return deployer.Apply(ctx)
 }
@@ -73,6 +86,9 @@ func (s *ClusterRoleBindingApplier) Validate(ctx context.Context) error {
 	if s.Context == "" {
 		return errors.New("context missing")
 	}
+	if err := s.Overlays.Validate(ctx); err != nil {
+		return err
+	}
 	return s.ClusterRoleBinding.Validate(ctx)
 }
 
@@ -16,6 +16,7 @@ import (
 
 type ConfigMapConfiguration struct {
 	Context      Context
+	Overlays     Overlays
 	ConfigMap    ConfigMap
 	Requirements []world.Configuration
 }
@@ -31,6 +32,7 @@ func (d *ConfigMapConfiguration) Validate(ctx context.Context) error {
 func (d *ConfigMapConfiguration) Applier() (world.Applier, error) {
 	return &ConfigMapApplier{
 		Context:   d.Context,
+		Overlays:  d.Overlays,
 		ConfigMap: d.ConfigMap,
 	}, nil
 }
@@ -41,17 +43,28 @@ func (d *ConfigMapConfiguration) Children(ctx context.Context) (world.Configurat
 
 type ConfigMapApplier struct {
 	Context   Context
+	Overlays  Overlays
 	ConfigMap ConfigMap
 }
 
+func (s *ConfigMapApplier) KubernetesContext() Context {
+	return s.Context
+}
+
 func (s *ConfigMapApplier) Satisfied(ctx context.Context) (bool, error) {
-	return false, nil
+	deployer := &Deployer{
+		Context:  s.Context,
+		Overlays: s.Overlays,
+		Data:     s.ConfigMap,
+	}
+	return deployer.Satisfied(ctx)
 }
 
 func (s *ConfigMapApplier) Apply(ctx context.Context) error {
 	deployer := &Deployer{
-		Context: s.Context,
-		Data:    s.ConfigMap,
+		Context:  s.Context,
+		Overlays: s.Overlays,
+		Data:     s.ConfigMap,
 	}
 	return deployer.Apply(ctx)
 }
@@ -60,6 +73,9 @@ func (s *ConfigMapApplier) Validate(ctx context.Context) error {
 	if s.Context == "" {
 		return errors.New("context missing")
 	}
+	if err := s.Overlays.Validate(ctx); err != nil {
+		return err
+	}
 	return s.ConfigMap.Validate(ctx)
 }
 
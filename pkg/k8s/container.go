@@ -125,6 +125,7 @@ type Container struct {
 	Command         []Command        `yaml:"command,omitempty"`
 	Args            []Arg            `yaml:"args,omitempty"`
 	Env             []Env            `yaml:"env,omitempty"`
+	EnvFrom         []EnvFrom        `yaml:"envFrom,omitempty"`
 	Ports           ContainerPorts   `yaml:"ports,omitempty"`
 	Resources       Resources        `yaml:"resources,omitempty"`
 	VolumeMounts    []ContainerMount `yaml:"volumeMounts,omitempty"`
@@ -0,0 +1,159 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package k8s
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/bborbe/world/pkg/validation"
+	"github.com/bborbe/world/pkg/world"
+)
+
+type CronJobConfiguration struct {
+	Context      Context
+	Overlays     Overlays
+	CronJob      CronJob
+	Requirements []world.Configuration
+}
+
+func (c *CronJobConfiguration) Validate(ctx context.Context) error {
+	return validation.Validate(
+		ctx,
+		c.Context,
+		c.CronJob,
+	)
+}
+
+func (c *CronJobConfiguration) Applier() (world.Applier, error) {
+	return &CronJobApplier{
+		Context:  c.Context,
+		Overlays: c.Overlays,
+		CronJob:  c.CronJob,
+	}, nil
+}
+
+func (c *CronJobConfiguration) Children(ctx context.Context) (world.Configurations, error) {
+	return c.Requirements, nil
+}
+
+type CronJobApplier struct {
+	Context  Context
+	Overlays Overlays
+	CronJob  CronJob
+}
+
+func (s *CronJobApplier) KubernetesContext() Context {
+	return s.Context
+}
+
+func (s *CronJobApplier) Satisfied(ctx context.Context) (bool, error) {
+	deployer := &Deployer{
+		Context:  s.Context,
+		Overlays: s.Overlays,
+		Data:     s.CronJob,
+	}
+	return deployer.Satisfied(ctx)
+}
+
+func (s *CronJobApplier) Apply(ctx context.Context) error {
+	deployer := &Deployer{
+		Context:  s.Context,
+		Overlays: s.Overlays,
+		Data:     s.CronJob,
+	}
+	return deployer.Apply(ctx)
+}
+
+func (s *CronJobApplier) Validate(ctx context.Context) error {
+	return validation.Validate(
+		ctx,
+		s.Context,
+		s.Overlays,
+		s.CronJob,
+	)
+}
+
+type CronJob struct {
+	ApiVersion ApiVersion  `yaml:"apiVersion"`
+	Kind       Kind        `yaml:"kind"`
+	Metadata   Metadata    `yaml:"metadata"`
+	Spec       CronJobSpec `yaml:"spec"`
+}
+
+func (s CronJob) String() string {
+	return fmt.Sprintf("%s/%s to %s", s.Kind, s.Metadata.Name, s.Metadata.Namespace)
+}
+
+func (s CronJob) Validate(ctx context.Context) error {
+	if s.ApiVersion != "batch/v1" {
+		return errors.New("invalid ApiVersion")
+	}
+	if s.Kind != "CronJob" {
+		return errors.New("invalid Kind")
+	}
+	return validation.Validate(
+		ctx,
+		s.Metadata,
+		s.Spec,
+	)
+}
+
+// CronJobSchedule in cron format, like "0 3 * * *" or "@daily".
+type CronJobSchedule string
+
+func (c CronJobSchedule) String() string {
+	return string(c)
+}
+
+func (c CronJobSchedule) Validate(ctx context.Context) error {
+	if strings.HasPrefix(c.String(), "@") {
+		return nil
+	}
+	if len(strings.Fields(c.String())) != 5 {
+		return errors.Errorf("invalid schedule '%s'", c)
+	}
+	return nil
+}
+
+type CronJobConcurrencyPolicy string
+
+const (
+	CronJobConcurrencyPolicyAllow   CronJobConcurrencyPolicy = "Allow"
+	CronJobConcurrencyPolicyForbid  CronJobConcurrencyPolicy = "Forbid"
+	CronJobConcurrencyPolicyReplace CronJobConcurrencyPolicy = "Replace"
+)
+
+func (c CronJobConcurrencyPolicy) Validate(ctx context.Context) error {
+	switch c {
+	case "", CronJobConcurrencyPolicyAllow, CronJobConcurrencyPolicyForbid, CronJobConcurrencyPolicyReplace:
+		return nil
+	default:
+		return errors.Errorf("invalid concurrencyPolicy '%s'", c)
+	}
+}
+
+type CronJobSpec struct {
+	Schedule                   CronJobSchedule          `yaml:"schedule"`
+	TimeZone                   string                   `yaml:"timeZone,omitempty"`
+	ConcurrencyPolicy          CronJobConcurrencyPolicy `yaml:"concurrencyPolicy,omitempty"`
+	Suspend                    bool                     `yaml:"suspend,omitempty"`
+	StartingDeadlineSeconds    int                      `yaml:"startingDeadlineSeconds,omitempty"`
+	SuccessfulJobsHistoryLimit int                      `yaml:"successfulJobsHistoryLimit,omitempty"`
+	FailedJobsHistoryLimit     int                      `yaml:"failedJobsHistoryLimit,omitempty"`
+	JobTemplate                JobTemplate              `yaml:"jobTemplate"`
+}
+
+func (c CronJobSpec) Validate(ctx context.Context) error {
+	return validation.Validate(
+		ctx,
+		c.Schedule,
+		c.ConcurrencyPolicy,
+		c.JobTemplate.Spec,
+	)
+}
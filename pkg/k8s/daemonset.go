@@ -16,8 +16,10 @@ import (
 
 type DaemonSetConfiguration struct {
 	Context      Context
+	Overlays     Overlays
 	DaemonSet    DaemonSet
 	Requirements []world.Configuration
+	Rollout      Rollout
 }
 
 func (d *DaemonSetConfiguration) Validate(ctx context.Context) error {
@@ -31,7 +33,9 @@ func (d *DaemonSetConfiguration) Validate(ctx context.Context) error {
 func (d *DaemonSetConfiguration) Applier() (world.Applier, error) {
 	return &DaemonSetApplier{
 		Context:   d.Context,
+		Overlays:  d.Overlays,
 		DaemonSet: d.DaemonSet,
+		Rollout:   d.Rollout,
 	}, nil
 }
 
@@ -41,25 +45,45 @@ func (d *DaemonSetConfiguration) Children(ctx context.Context) (world.Configurat
 
 type DaemonSetApplier struct {
 	Context   Context
+	Overlays  Overlays
 	DaemonSet DaemonSet
+	Rollout   Rollout
+}
+
+func (s *DaemonSetApplier) KubernetesContext() Context {
+	return s.Context
 }
 
 func (s *DaemonSetApplier) Satisfied(ctx context.Context) (bool, error) {
-	return false, nil
+	deployer := &Deployer{
+		Context:  s.Context,
+		Overlays: s.Overlays,
+		Data:     s.DaemonSet,
+	}
+	return deployer.Satisfied(ctx)
 }
 
 func (s *DaemonSetApplier) Apply(ctx context.Context) error {
 	deployer := &Deployer{
-		Context: s.Context,
-		Data:    s.DaemonSet,
+		Context:  s.Context,
+		Overlays: s.Overlays,
+		Data:     s.DaemonSet,
+	}
+	if err := deployer.Apply(ctx); err != nil {
+		return err
+	}
+	ref, err := deployer.Reference()
+	if err != nil {
+		return err
 	}
-	return deployer.Apply(ctx)
+	return s.Rollout.Wait(ctx, s.Context, *ref)
 }
 
 func (s *DaemonSetApplier) Validate(ctx context.Context) error {
 	return validation.Validate(
 		ctx,
 		s.Context,
+		s.Overlays,
 		s.DaemonSet,
 	)
 }
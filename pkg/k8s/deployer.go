@@ -7,41 +7,341 @@ package k8s
 import (
 	"bytes"
 	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"net/http"
 	"os"
 	"os/exec"
+	"strings"
+	"sync"
+	"time"
 
 	"github.com/golang/glog"
 	"github.com/pkg/errors"
 	"gopkg.in/yaml.v2"
 )
 
+// Transport used by the Deployer to talk to the cluster.
+type Transport string
+
+const (
+	// TransportAuto uses the api client and falls back to kubectl if the kubeconfig is not supported.
+	TransportAuto    Transport = "auto"
+	TransportNative  Transport = "native"
+	TransportKubectl Transport = "kubectl"
+)
+
+func (t Transport) String() string {
+	return string(t)
+}
+
+// ContentHashAnnotation contains the hash of the applied object, used to detect changes.
+const ContentHashAnnotation = "world/content-hash"
+
+const (
+	DefaultDeleteTimeout  = 2 * time.Minute
+	DefaultDeleteInterval = 2 * time.Second
+)
+
+// DefaultTransport is used by all Deployers.
+var DefaultTransport = TransportAuto
+
+var clients = struct {
+	mux     sync.Mutex
+	clients map[Context]*Client
+}{
+	clients: map[Context]*Client{},
+}
+
+// ClientForContext returns a cached api client for the kubeconfig context.
+func ClientForContext(context Context) (*Client, error) {
+	clients.mux.Lock()
+	defer clients.mux.Unlock()
+	if client, ok := clients.clients[context]; ok {
+		return client, nil
+	}
+	client, err := NewClient(context, KubeconfigPaths()...)
+	if err != nil {
+		return nil, err
+	}
+	clients.clients[context] = client
+	return client, nil
+}
+
 type Deployer struct {
 	Context Context
-	Data    interface {
+	// Overlays are applied to the object before it is hashed and applied.
+	Overlays Overlays
+	Data     interface {
 		String() string
 	}
 }
 
+// Satisfied compares the content hash annotation of the live object with the rendered object.
+// Changes made to the live object by others are not detected.
+func (d *Deployer) Satisfied(ctx context.Context) (bool, error) {
+	_, hash, err := d.render()
+	if err != nil {
+		return false, err
+	}
+	live, err := d.Get(ctx)
+	if IsNotFound(err) {
+		glog.V(3).Infof("%s not found in %s", d.Data, d.Context)
+		return false, nil
+	}
+	if err != nil {
+		return false, errors.Wrapf(err, "get %s from %s failed", d.Data, d.Context)
+	}
+	var object struct {
+		Metadata struct {
+			Annotations map[string]string `json:"annotations"`
+		} `json:"metadata"`
+	}
+	if err := json.Unmarshal(live, &object); err != nil {
+		return false, errors.Wrap(err, "parse live object failed")
+	}
+	return object.Metadata.Annotations[ContentHashAnnotation] == hash, nil
+}
+
 func (d *Deployer) Apply(ctx context.Context) error {
 	glog.V(3).Infof("deploy %s to %s ...", d.Data, d.Context)
+	data, _, err := d.render()
+	if err != nil {
+		return err
+	}
+	if glog.V(4) {
+		glog.Infof("yaml: %s", string(data))
+	}
+	if err := d.apply(ctx, data); err != nil {
+		return errors.Wrapf(err, "deploy %T to %s failed", d.Data, d.Context)
+	}
+	glog.V(3).Infof("deploy %s to %s finished", d.Data, d.Context)
+	return nil
+}
+
+// Recreate deletes the live object if it exists and applies the object again.
+// Required for objects with immutable fields, like the pod template of a Job.
+func (d *Deployer) Recreate(ctx context.Context) error {
+	_, err := d.Get(ctx)
+	if err != nil && !IsNotFound(err) {
+		return errors.Wrapf(err, "get %s from %s failed", d.Data, d.Context)
+	}
+	if err == nil {
+		if err := d.Delete(ctx); err != nil {
+			return err
+		}
+		if err := d.waitDeleted(ctx); err != nil {
+			return err
+		}
+	}
+	return d.Apply(ctx)
+}
+
+// waitDeleted returns after the live object is gone, finalizers can delay the deletion.
+func (d *Deployer) waitDeleted(ctx context.Context) error {
+	deadline := time.Now().Add(DefaultDeleteTimeout)
+	for {
+		_, err := d.Get(ctx)
+		if IsNotFound(err) {
+			return nil
+		}
+		if err != nil {
+			return errors.Wrapf(err, "get %s from %s failed", d.Data, d.Context)
+		}
+		if time.Now().After(deadline) {
+			return errors.Errorf("%s in %s not deleted after %v", d.Data, d.Context, DefaultDeleteTimeout)
+		}
+		glog.V(3).Infof("wait for deletion of %s in %s", d.Data, d.Context)
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(DefaultDeleteInterval):
+		}
+	}
+}
+
+// Reference returns the reference of the object after the overlays are applied.
+func (d *Deployer) Reference() (*ObjectReference, error) {
+	data, _, err := d.render()
+	if err != nil {
+		return nil, err
+	}
+	return ParseObjectReference(data)
+}
+
+// render encodes the data as yaml with the overlays and the content hash annotation.
+func (d *Deployer) render() ([]byte, string, error) {
 	buf := &bytes.Buffer{}
 	if err := yaml.NewEncoder(buf).Encode(d.Data); err != nil {
+		return nil, "", errors.Wrapf(err, "encode %s failed", d.Data)
+	}
+	content, err := ApplyOverlays(buf.Bytes(), d.Overlays...)
+	if err != nil {
+		return nil, "", errors.Wrapf(err, "apply overlays to %s failed", d.Data)
+	}
+	hash := fmt.Sprintf("%x", sha256.Sum256(content))
+	data, err := SetAnnotation(content, ContentHashAnnotation, hash)
+	if err != nil {
+		return nil, "", err
+	}
+	return data, hash, nil
+}
+
+// Get returns the live object as json.
+func (d *Deployer) Get(ctx context.Context) ([]byte, error) {
+	data, _, err := d.render()
+	if err != nil {
+		return nil, err
+	}
+	if renderFromContext(ctx) != nil {
+		return nil, errRenderNotFound
+	}
+	if DefaultTransport == TransportKubectl {
+		return d.kubectlGet(ctx, data)
+	}
+	client, err := ClientForContext(d.Context)
+	if err != nil {
+		if DefaultTransport == TransportAuto && errors.Cause(err) == ErrUnsupportedKubeconfig {
+			return d.kubectlGet(ctx, data)
+		}
+		return nil, errors.Wrapf(err, "create client for %s failed", d.Context)
+	}
+	ref, err := ParseObjectReference(data)
+	if err != nil {
+		return nil, err
+	}
+	return client.Get(ctx, *ref)
+}
+
+// Delete the live object, missing objects are ignored.
+func (d *Deployer) Delete(ctx context.Context) error {
+	data, _, err := d.render()
+	if err != nil {
 		return err
 	}
-	if glog.V(4) {
-		glog.Infof("yaml: %s", buf.String())
+	if renderFromContext(ctx) != nil {
+		glog.V(2).Infof("skip delete %s in render mode", d.Data.String())
+		return nil
 	}
+	glog.V(1).Infof("delete %s", d.Data.String())
+	if DefaultTransport == TransportKubectl {
+		return d.kubectlDelete(ctx, data)
+	}
+	client, err := ClientForContext(d.Context)
+	if err != nil {
+		if DefaultTransport == TransportAuto && errors.Cause(err) == ErrUnsupportedKubeconfig {
+			return d.kubectlDelete(ctx, data)
+		}
+		return errors.Wrapf(err, "create client for %s failed", d.Context)
+	}
+	ref, err := ParseObjectReference(data)
+	if err != nil {
+		return err
+	}
+	if err := client.Delete(ctx, *ref); err != nil && !IsNotFound(err) {
+		return errors.Wrapf(err, "delete %s failed", ref)
+	}
+	return nil
+}
+
+func (d *Deployer) kubectlDelete(ctx context.Context, data []byte) error {
+	cmd := exec.CommandContext(ctx, "kubectl", "--context", d.Context.String(), "delete", "--ignore-not-found", "-f", "-")
+	cmd.Stdin = bytes.NewReader(data)
+	if output, err := cmd.CombinedOutput(); err != nil {
+		return errors.Wrapf(err, "kubectl delete failed: %s", string(output))
+	}
+	return nil
+}
+
+// clusterScopedKinds is used to decide about namespaces without api access.
+var clusterScopedKinds = map[Kind]bool{
+	"APIService":                     true,
+	"CertificateSigningRequest":      true,
+	"ClusterIssuer":                  true,
+	"ClusterRole":                    true,
+	"ClusterRoleBinding":             true,
+	"CSIDriver":                      true,
+	"CustomResourceDefinition":       true,
+	"IngressClass":                   true,
+	"MutatingWebhookConfiguration":   true,
+	"Namespace":                      true,
+	"Node":                           true,
+	"PersistentVolume":               true,
+	"PriorityClass":                  true,
+	"RuntimeClass":                   true,
+	"StorageClass":                   true,
+	"ValidatingWebhookConfiguration": true,
+	"VolumeAttachment":               true,
+}
+
+// Namespaced returns true if objects of the kind belong to a namespace.
+// The api is asked if possible, otherwise or if the kind is not yet known
+// a list of well known cluster scoped kinds is used.
+func Namespaced(ctx context.Context, context Context, apiVersion ApiVersion, kind Kind) (bool, error) {
+	if renderFromContext(ctx) == nil && DefaultTransport != TransportKubectl {
+		client, err := ClientForContext(context)
+		if err == nil {
+			resource, err := client.resource(ctx, apiVersion, kind)
+			if IsNotFound(err) {
+				glog.V(2).Infof("kind %s of %s not found in %s => use well known kinds", kind, apiVersion, context)
+				return !clusterScopedKinds[kind], nil
+			}
+			if err != nil {
+				return false, err
+			}
+			return resource.Namespaced, nil
+		}
+		if DefaultTransport == TransportNative || errors.Cause(err) != ErrUnsupportedKubeconfig {
+			return false, errors.Wrapf(err, "create client for %s failed", context)
+		}
+	}
+	return !clusterScopedKinds[kind], nil
+}
+
+func (d *Deployer) kubectlGet(ctx context.Context, data []byte) ([]byte, error) {
+	stdout := &bytes.Buffer{}
+	stderr := &bytes.Buffer{}
+	cmd := exec.CommandContext(ctx, "kubectl", "--context", d.Context.String(), "get", "-o", "json", "-f", "-")
+	cmd.Stdin = bytes.NewReader(data)
+	cmd.Stdout = stdout
+	cmd.Stderr = stderr
+	if err := cmd.Run(); err != nil {
+		if strings.Contains(stderr.String(), "NotFound") {
+			return nil, &StatusError{Code: http.StatusNotFound, Reason: "NotFound", Message: strings.TrimSpace(stderr.String())}
+		}
+		return nil, errors.Wrapf(err, "kubectl get failed: %s", stderr.String())
+	}
+	return stdout.Bytes(), nil
+}
+
+func (d *Deployer) apply(ctx context.Context, data []byte) error {
+	if render := renderFromContext(ctx); render != nil {
+		return render.write(d.Context, data)
+	}
+	if DefaultTransport == TransportKubectl {
+		return d.kubectlApply(ctx, data)
+	}
+	client, err := ClientForContext(d.Context)
+	if err != nil {
+		if DefaultTransport == TransportAuto && errors.Cause(err) == ErrUnsupportedKubeconfig {
+			glog.V(2).Infof("%v => fallback to kubectl", err)
+			return d.kubectlApply(ctx, data)
+		}
+		return errors.Wrapf(err, "create client for %s failed", d.Context)
+	}
+	glog.V(1).Infof("apply %s", d.Data.String())
+	return client.Apply(ctx, data)
+}
+
+// kubectlApply uses client-side apply, so kubectl does not take ownership of fields managed by others.
+func (d *Deployer) kubectlApply(ctx context.Context, data []byte) error {
 	glog.V(1).Infof("kubectl apply %s", d.Data.String())
 	cmd := exec.CommandContext(ctx, "kubectl", "--context", d.Context.String(), "apply", "-f", "-")
-	cmd.Stdin = buf
+	cmd.Stdin = bytes.NewReader(data)
 	if glog.V(4) {
 		cmd.Stdout = os.Stdout
 		cmd.Stderr = os.Stderr
 	}
-	if err := cmd.Run(); err != nil {
-		return errors.Wrapf(err, "deploy %T to %s failed", d.Data, d.Context)
-
-	}
-	glog.V(3).Infof("deploy %s to %s finished", d.Data, d.Context)
-	return nil
+	return cmd.Run()
 }
@@ -0,0 +1,152 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package k8s_test
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"regexp"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/bborbe/world/pkg/k8s"
+)
+
+var _ = Describe("SetAnnotation", func() {
+	It("adds annotation to existing annotations", func() {
+		data, err := k8s.SetAnnotation([]byte("kind: Namespace\nmetadata:\n  name: fruits\n  annotations:\n    a: b\n"), "world/content-hash", "abc")
+		Expect(err).To(BeNil())
+		Expect(string(data)).To(Equal("kind: Namespace\nmetadata:\n  name: fruits\n  annotations:\n    a: b\n    world/content-hash: abc\n"))
+	})
+	It("creates annotations", func() {
+		data, err := k8s.SetAnnotation([]byte("kind: Namespace\nmetadata:\n  name: fruits\n"), "world/content-hash", "abc")
+		Expect(err).To(BeNil())
+		Expect(string(data)).To(Equal("kind: Namespace\nmetadata:\n  name: fruits\n  annotations:\n    world/content-hash: abc\n"))
+	})
+})
+
+var _ = Describe("Deployer", func() {
+	var ctx context.Context
+	var server *httptest.Server
+	var liveHash string
+	var deletes int
+	var kubeconfigPath string
+	var kubeconfigBefore string
+	var deployer *k8s.Deployer
+	BeforeEach(func() {
+		ctx = context.Background()
+		liveHash = ""
+		deletes = 0
+		hashRegexp := regexp.MustCompile(`world/content-hash: (\w+)`)
+		server = httptest.NewServer(http.HandlerFunc(func(resp http.ResponseWriter, req *http.Request) {
+			body, _ := ioutil.ReadAll(req.Body)
+			switch {
+			case req.URL.Path == "/api/v1":
+				_, _ = resp.Write([]byte(`{"resources":[{"name":"namespaces","kind":"Namespace","namespaced":false}]}`))
+			case req.Method == http.MethodDelete:
+				deletes++
+				liveHash = ""
+				_, _ = resp.Write([]byte(`{}`))
+			case req.Method == http.MethodPatch:
+				liveHash = hashRegexp.FindStringSubmatch(string(body))[1]
+				_, _ = resp.Write([]byte(`{}`))
+			case liveHash == "":
+				resp.WriteHeader(http.StatusNotFound)
+				_, _ = resp.Write([]byte(`{"kind":"Status","reason":"NotFound","code":404}`))
+			default:
+				_, _ = fmt.Fprintf(resp, `{"metadata":{"annotations":{"world/content-hash":"%s"}}}`, liveHash)
+			}
+		}))
+		counter++
+		contextName := fmt.Sprintf("deployer-%d", counter)
+		kubeconfigPath = writeKubeconfig(server.URL, contextName)
+		kubeconfigBefore = os.Getenv("KUBECONFIG")
+		Expect(os.Setenv("KUBECONFIG", kubeconfigPath)).To(BeNil())
+		deployer = &k8s.Deployer{
+			Context: k8s.Context(contextName),
+			Data: k8s.Namespace{
+				ApiVersion: "v1",
+				Kind:       "Namespace",
+				Metadata: k8s.Metadata{
+					Name: "fruits",
+				},
+			},
+		}
+	})
+	AfterEach(func() {
+		server.Close()
+		_ = os.Setenv("KUBECONFIG", kubeconfigBefore)
+		_ = os.RemoveAll(filepath.Dir(kubeconfigPath))
+	})
+	It("is not satisfied if object is missing", func() {
+		satisfied, err := deployer.Satisfied(ctx)
+		Expect(err).To(BeNil())
+		Expect(satisfied).To(BeFalse())
+	})
+	It("is satisfied after apply", func() {
+		Expect(deployer.Apply(ctx)).To(BeNil())
+		satisfied, err := deployer.Satisfied(ctx)
+		Expect(err).To(BeNil())
+		Expect(satisfied).To(BeTrue())
+	})
+	It("is not satisfied if object changed", func() {
+		Expect(deployer.Apply(ctx)).To(BeNil())
+		deployer.Data = k8s.Namespace{
+			ApiVersion: "v1",
+			Kind:       "Namespace",
+			Metadata: k8s.Metadata{
+				Name:   "fruits",
+				Labels: k8s.Labels{"app": "fruits"},
+			},
+		}
+		satisfied, err := deployer.Satisfied(ctx)
+		Expect(err).To(BeNil())
+		Expect(satisfied).To(BeFalse())
+	})
+	It("recreates existing object", func() {
+		Expect(deployer.Apply(ctx)).To(BeNil())
+		Expect(deployer.Recreate(ctx)).To(BeNil())
+		Expect(deletes).To(Equal(1))
+		satisfied, err := deployer.Satisfied(ctx)
+		Expect(err).To(BeNil())
+		Expect(satisfied).To(BeTrue())
+	})
+	It("creates missing object without delete", func() {
+		Expect(deployer.Recreate(ctx)).To(BeNil())
+		Expect(deletes).To(Equal(0))
+	})
+})
+
+var counter int
+
+// writeKubeconfig creates a kubeconfig with a new context, because clients are cached by context.
+func writeKubeconfig(server string, contextName string) string {
+	dir, err := ioutil.TempDir("", "kubeconfig")
+	Expect(err).To(BeNil())
+	path := filepath.Join(dir, "config")
+	Expect(ioutil.WriteFile(path, []byte(`apiVersion: v1
+kind: Config
+clusters:
+- name: test
+  cluster:
+    server: `+server+`
+contexts:
+- name: `+contextName+`
+  context:
+    cluster: test
+    user: test
+users:
+- name: test
+  user:
+    token: secret-token
+`), 0600)).To(BeNil())
+	return path
+}
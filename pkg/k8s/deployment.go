@@ -17,8 +17,10 @@ import (
 
 type DeploymentConfiguration struct {
 	Context      Context
+	Overlays     Overlays
 	Deployment   Deployment
 	Requirements []world.Configuration
+	Rollout      Rollout
 }
 
 func (d *DeploymentConfiguration) Validate(ctx context.Context) error {
@@ -32,7 +34,9 @@ func (d *DeploymentConfiguration) Validate(ctx context.Context) error {
 func (d *DeploymentConfiguration) Applier() (world.Applier, error) {
 	return &DeploymentApplier{
 		Context:    d.Context,
+		Overlays:   d.Overlays,
 		Deployment: d.Deployment,
+		Rollout:    d.Rollout,
 	}, nil
 }
 
@@ -42,25 +46,45 @@ func (d *DeploymentConfiguration) Children(ctx context.Context) (world.Configura
 
 type DeploymentApplier struct {
 	Context    Context
+	Overlays   Overlays
 	Deployment Deployment
+	Rollout    Rollout
+}
+
+func (s *DeploymentApplier) KubernetesContext() Context {
+	return s.Context
 }
 
 func (s *DeploymentApplier) Satisfied(ctx context.Context) (bool, error) {
-	return false, nil
+	deployer := &Deployer{
+		Context:  s.Context,
+		Overlays: s.Overlays,
+		Data:     s.Deployment,
+	}
+	return deployer.Satisfied(ctx)
 }
 
 func (s *DeploymentApplier) Apply(ctx context.Context) error {
 	deployer := &Deployer{
-		Context: s.Context,
-		Data:    s.Deployment,
+		Context:  s.Context,
+		Overlays: s.Overlays,
+		Data:     s.Deployment,
+	}
+	if err := deployer.Apply(ctx); err != nil {
+		return err
+	}
+	ref, err := deployer.Reference()
+	if err != nil {
+		return err
 	}
-	return deployer.Apply(ctx)
+	return s.Rollout.Wait(ctx, s.Context, *ref)
 }
 
 func (s *DeploymentApplier) Validate(ctx context.Context) error {
 	return validation.Validate(
 		ctx,
 		s.Context,
+		s.Overlays,
 		s.Deployment,
 	)
 }
@@ -0,0 +1,166 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package k8s
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+
+	"github.com/bborbe/world/pkg/validation"
+	"github.com/bborbe/world/pkg/world"
+)
+
+type HorizontalPodAutoscalerConfiguration struct {
+	Context                 Context
+	Overlays                Overlays
+	HorizontalPodAutoscaler HorizontalPodAutoscaler
+	Requirements            []world.Configuration
+}
+
+func (c *HorizontalPodAutoscalerConfiguration) Validate(ctx context.Context) error {
+	return validation.Validate(
+		ctx,
+		c.Context,
+		c.HorizontalPodAutoscaler,
+	)
+}
+
+func (c *HorizontalPodAutoscalerConfiguration) Applier() (world.Applier, error) {
+	return &HorizontalPodAutoscalerApplier{
+		Context:                 c.Context,
+		Overlays:                c.Overlays,
+		HorizontalPodAutoscaler: c.HorizontalPodAutoscaler,
+	}, nil
+}
+
+func (c *HorizontalPodAutoscalerConfiguration) Children(ctx context.Context) (world.Configurations, error) {
+	return c.Requirements, nil
+}
+
+type HorizontalPodAutoscalerApplier struct {
+	Context                 Context
+	Overlays                Overlays
+	HorizontalPodAutoscaler HorizontalPodAutoscaler
+}
+
+func (s *HorizontalPodAutoscalerApplier) KubernetesContext() Context {
+	return s.Context
+}
+
+func (s *HorizontalPodAutoscalerApplier) Satisfied(ctx context.Context) (bool, error) {
+	deployer := &Deployer{
+		Context:  s.Context,
+		Overlays: s.Overlays,
+		Data:     s.HorizontalPodAutoscaler,
+	}
+	return deployer.Satisfied(ctx)
+}
+
+func (s *HorizontalPodAutoscalerApplier) Apply(ctx context.Context) error {
+	deployer := &Deployer{
+		Context:  s.Context,
+		Overlays: s.Overlays,
+		Data:     s.HorizontalPodAutoscaler,
+	}
+	return deployer.Apply(ctx)
+}
+
+func (s *HorizontalPodAutoscalerApplier) Validate(ctx context.Context) error {
+	return validation.Validate(
+		ctx,
+		s.Context,
+		s.Overlays,
+		s.HorizontalPodAutoscaler,
+	)
+}
+
+type HorizontalPodAutoscaler struct {
+	ApiVersion ApiVersion                  `yaml:"apiVersion"`
+	Kind       Kind                        `yaml:"kind"`
+	Metadata   Metadata                    `yaml:"metadata"`
+	Spec       HorizontalPodAutoscalerSpec `yaml:"spec"`
+}
+
+func (s HorizontalPodAutoscaler) String() string {
+	return fmt.Sprintf("%s/%s to %s", s.Kind, s.Metadata.Name, s.Metadata.Namespace)
+}
+
+func (s HorizontalPodAutoscaler) Validate(ctx context.Context) error {
+	if s.ApiVersion != "autoscaling/v2" {
+		return errors.New("invalid ApiVersion")
+	}
+	if s.Kind != "HorizontalPodAutoscaler" {
+		return errors.New("invalid Kind")
+	}
+	return validation.Validate(
+		ctx,
+		s.Metadata,
+		s.Spec,
+	)
+}
+
+type HorizontalPodAutoscalerSpec struct {
+	ScaleTargetRef CrossVersionObjectReference `yaml:"scaleTargetRef"`
+	MinReplicas    Replicas                    `yaml:"minReplicas,omitempty"`
+	MaxReplicas    Replicas                    `yaml:"maxReplicas"`
+	Metrics        []MetricSpec                `yaml:"metrics,omitempty"`
+}
+
+func (h HorizontalPodAutoscalerSpec) Validate(ctx context.Context) error {
+	if h.MinReplicas > h.MaxReplicas {
+		return errors.Errorf("minReplicas %d greater than maxReplicas %d", h.MinReplicas, h.MaxReplicas)
+	}
+	for _, metric := range h.Metrics {
+		if err := metric.Validate(ctx); err != nil {
+			return err
+		}
+	}
+	return validation.Validate(
+		ctx,
+		h.ScaleTargetRef,
+		h.MaxReplicas,
+	)
+}
+
+type CrossVersionObjectReference struct {
+	ApiVersion ApiVersion   `yaml:"apiVersion"`
+	Kind       Kind         `yaml:"kind"`
+	Name       MetadataName `yaml:"name"`
+}
+
+func (c CrossVersionObjectReference) Validate(ctx context.Context) error {
+	return validation.Validate(
+		ctx,
+		c.Kind,
+		c.Name,
+	)
+}
+
+type MetricSpec struct {
+	Type     string                `yaml:"type"`
+	Resource *ResourceMetricSource `yaml:"resource,omitempty"`
+}
+
+func (m MetricSpec) Validate(ctx context.Context) error {
+	if m.Type == "Resource" && m.Resource == nil {
+		return errors.New("resource metric missing")
+	}
+	return nil
+}
+
+// ResourceMetricSource scales on cpu or memory of the pods.
+type ResourceMetricSource struct {
+	Name   string       `yaml:"name"`
+	Target MetricTarget `yaml:"target"`
+}
+
+type MetricTarget struct {
+	Type               string `yaml:"type"`
+	AverageUtilization int    `yaml:"averageUtilization,omitempty"`
+	AverageValue       string `yaml:"averageValue,omitempty"`
+	Value              string `yaml:"value,omitempty"`
+}
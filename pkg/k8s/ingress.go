@@ -7,6 +7,7 @@ package k8s
 import (
 	"context"
 	"fmt"
+	"strconv"
 	"strings"
 
 	"github.com/pkg/errors"
@@ -15,8 +16,11 @@ import (
 	"github.com/bborbe/world/pkg/world"
 )
 
+const IngressApiVersionV1 ApiVersion = "networking.k8s.io/v1"
+
+// BuildIngressConfigurationWithCertManager uses the Ingress api of the cluster version.
 func BuildIngressConfigurationWithCertManager(
-	context Context,
+	cluster Cluster,
 	namespace NamespaceName,
 	name MetadataName,
 	serviceName IngressBackendServiceName,
@@ -24,22 +28,28 @@ func BuildIngressConfigurationWithCertManager(
 	path IngressPathPath,
 	hosts ...IngressHost,
 ) *IngresseConfiguration {
+	apiVersion := cluster.Version.IngressApiVersion()
 	result := &IngresseConfiguration{
-		Context: context,
+		Context:  cluster.Context,
+		Overlays: cluster.Overlays,
 		Ingress: Ingress{
-			ApiVersion: "extensions/v1beta1",
+			ApiVersion: apiVersion,
 			Kind:       "Ingress",
 			Metadata: Metadata{
 				Namespace: namespace,
 				Name:      name,
 				Annotations: Annotations{
-					"kubernetes.io/ingress.class":                    "nginx",
 					"nginx.ingress.kubernetes.io/force-ssl-redirect": "true",
 					"cert-manager.io/cluster-issuer":                 "letsencrypt-http-live",
 				},
 			},
 		},
 	}
+	if apiVersion == IngressApiVersionV1 {
+		result.Ingress.Spec.IngressClassName = "nginx"
+	} else {
+		result.Ingress.Metadata.Annotations["kubernetes.io/ingress.class"] = "nginx"
+	}
 	for _, host := range hosts {
 		result.Ingress.Spec.TLS = append(result.Ingress.Spec.TLS, IngressTLS{
 			Hosts: []string{
@@ -51,13 +61,7 @@ func BuildIngressConfigurationWithCertManager(
 			Host: host,
 			Http: IngressHttp{
 				Paths: []IngressPath{
-					{
-						Backends: IngressBackend{
-							ServiceName: serviceName,
-							ServicePort: servicePort,
-						},
-						Path: path,
-					},
+					NewIngressPath(apiVersion, path, serviceName, servicePort),
 				},
 			},
 		})
@@ -67,6 +71,7 @@ func BuildIngressConfigurationWithCertManager(
 
 type IngresseConfiguration struct {
 	Context      Context
+	Overlays     Overlays
 	Ingress      Ingress
 	Requirements []world.Configuration
 }
@@ -81,8 +86,9 @@ func (i *IngresseConfiguration) Validate(ctx context.Context) error {
 
 func (i *IngresseConfiguration) Applier() (world.Applier, error) {
 	return &IngressApplier{
-		Context: i.Context,
-		Ingress: i.Ingress,
+		Context:  i.Context,
+		Overlays: i.Overlays,
+		Ingress:  i.Ingress,
 	}, nil
 }
 
@@ -91,18 +97,29 @@ func (i *IngresseConfiguration) Children(ctx context.Context) (world.Configurati
 }
 
 type IngressApplier struct {
-	Context Context
-	Ingress Ingress
+	Context  Context
+	Overlays Overlays
+	Ingress  Ingress
+}
+
+func (i *IngressApplier) KubernetesContext() Context {
+	return i.Context
 }
 
 func (i *IngressApplier) Satisfied(ctx context.Context) (bool, error) {
-	return false, nil
+	deployer := &Deployer{
+		Context:  i.Context,
+		Overlays: i.Overlays,
+		Data:     i.Ingress,
+	}
+	return deployer.Satisfied(ctx)
 }
 
 func (i *IngressApplier) Apply(ctx context.Context) error {
 	deployer := &Deployer{
-		Context: i.Context,
-		Data:    i.Ingress,
+		Context:  i.Context,
+		Overlays: i.Overlays,
+		Data:     i.Ingress,
 	}
 	return deployer.Apply(ctx)
 }
@@ -111,6 +128,9 @@ func (i *IngressApplier) Validate(ctx context.Context) error {
 	if i.Context == "" {
 		return errors.New("context missing")
 	}
+	if err := i.Overlays.Validate(ctx); err != nil {
+		return err
+	}
 	return i.Ingress.Validate(ctx)
 }
 
@@ -126,18 +146,28 @@ func (s Ingress) String() string {
 }
 
 func (s Ingress) Validate(ctx context.Context) error {
-	if s.ApiVersion != "extensions/v1beta1" {
+	switch s.ApiVersion {
+	case IngressApiVersionV1, "networking.k8s.io/v1beta1", "extensions/v1beta1":
+	default:
 		return errors.New("invalid ApiVersion")
 	}
 	if s.Kind != "Ingress" {
 		return errors.New("invalid Kind")
 	}
+	for _, rule := range s.Spec.Rules {
+		for _, path := range rule.Http.Paths {
+			if err := path.validate(s.ApiVersion); err != nil {
+				return errors.Wrapf(err, "invalid path %s of host %s", path.Path, rule.Host)
+			}
+		}
+	}
 	return nil
 }
 
 type IngressSpec struct {
-	Rules []IngressRule `yaml:"rules"`
-	TLS   []IngressTLS  `yaml:"tls,omitempty"`
+	IngressClassName string        `yaml:"ingressClassName,omitempty"`
+	Rules            []IngressRule `yaml:"rules"`
+	TLS              []IngressTLS  `yaml:"tls,omitempty"`
 }
 
 func (i IngressSpec) Validate(ctx context.Context) error {
@@ -194,16 +224,91 @@ type IngressHttp struct {
 
 type IngressPathPath string
 
+// IngressPathType is required since networking.k8s.io/v1.
+type IngressPathType string
+
+const (
+	IngressPathTypePrefix                 IngressPathType = "Prefix"
+	IngressPathTypeExact                  IngressPathType = "Exact"
+	IngressPathTypeImplementationSpecific IngressPathType = "ImplementationSpecific"
+)
+
 type IngressPath struct {
 	Backends IngressBackend  `yaml:"backend"`
 	Path     IngressPathPath `yaml:"path"`
+	PathType IngressPathType `yaml:"pathType,omitempty"`
+}
+
+// NewIngressPath returns a prefix path to the service in the shape of the api version.
+func NewIngressPath(apiVersion ApiVersion, path IngressPathPath, serviceName IngressBackendServiceName, servicePort IngressBackendServicePort) IngressPath {
+	if apiVersion != IngressApiVersionV1 {
+		return IngressPath{
+			Path: path,
+			Backends: IngressBackend{
+				ServiceName: serviceName,
+				ServicePort: servicePort,
+			},
+		}
+	}
+	port := IngressServiceBackendPort{}
+	if number, err := strconv.Atoi(string(servicePort)); err == nil {
+		port.Number = number
+	} else {
+		port.Name = string(servicePort)
+	}
+	return IngressPath{
+		Path:     path,
+		PathType: IngressPathTypePrefix,
+		Backends: IngressBackend{
+			Service: &IngressServiceBackend{
+				Name: serviceName,
+				Port: port,
+			},
+		},
+	}
+}
+
+func (i IngressPath) validate(apiVersion ApiVersion) error {
+	if apiVersion != IngressApiVersionV1 {
+		if i.Backends.ServiceName == "" || i.Backends.Service != nil {
+			return errors.Errorf("%s requires serviceName backend", apiVersion)
+		}
+		return nil
+	}
+	switch i.PathType {
+	case IngressPathTypePrefix, IngressPathTypeExact, IngressPathTypeImplementationSpecific:
+	default:
+		return errors.Errorf("invalid pathType '%s'", i.PathType)
+	}
+	if i.Backends.Service == nil || i.Backends.ServiceName != "" {
+		return errors.Errorf("%s requires service backend", apiVersion)
+	}
+	if i.Backends.Service.Name == "" {
+		return errors.New("service name missing")
+	}
+	if (i.Backends.Service.Port.Name == "") == (i.Backends.Service.Port.Number == 0) {
+		return errors.New("service port requires either name or number")
+	}
+	return nil
 }
 
 type IngressBackendServiceName string
 
 type IngressBackendServicePort string
 
+// IngressBackend contains serviceName and servicePort before networking.k8s.io/v1 and service afterwards.
 type IngressBackend struct {
-	ServiceName IngressBackendServiceName `yaml:"serviceName"`
-	ServicePort IngressBackendServicePort `yaml:"servicePort"`
+	ServiceName IngressBackendServiceName `yaml:"serviceName,omitempty"`
+	ServicePort IngressBackendServicePort `yaml:"servicePort,omitempty"`
+	Service     *IngressServiceBackend    `yaml:"service,omitempty"`
+}
+
+type IngressServiceBackend struct {
+	Name IngressBackendServiceName `yaml:"name"`
+	Port IngressServiceBackendPort `yaml:"port"`
+}
+
+type IngressServiceBackendPort struct {
+	Name   string `yaml:"name,omitempty"`
+	Number int    `yaml:"number,omitempty"`
 }
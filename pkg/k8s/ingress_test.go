@@ -104,3 +104,58 @@ func TestIngressHostValidate(t *testing.T) {
 		})
 	}
 }
+
+func TestIngressValidate(t *testing.T) {
+	var tests = []struct {
+		name        string
+		apiVersion  k8s.ApiVersion
+		path        k8s.IngressPath
+		expectError bool
+	}{
+		{
+			name:        "v1",
+			apiVersion:  k8s.IngressApiVersionV1,
+			path:        k8s.NewIngressPath(k8s.IngressApiVersionV1, "/", "banana", "http"),
+			expectError: false,
+		},
+		{
+			name:        "v1 without pathType",
+			apiVersion:  k8s.IngressApiVersionV1,
+			path:        k8s.IngressPath{Path: "/", Backends: k8s.IngressBackend{Service: &k8s.IngressServiceBackend{Name: "banana", Port: k8s.IngressServiceBackendPort{Number: 80}}}},
+			expectError: true,
+		},
+		{
+			name:        "v1 with legacy backend",
+			apiVersion:  k8s.IngressApiVersionV1,
+			path:        k8s.NewIngressPath("extensions/v1beta1", "/", "banana", "http"),
+			expectError: true,
+		},
+		{
+			name:        "v1beta1",
+			apiVersion:  "extensions/v1beta1",
+			path:        k8s.NewIngressPath("extensions/v1beta1", "/", "banana", "http"),
+			expectError: false,
+		},
+		{
+			name:        "unknown api",
+			apiVersion:  "networking.k8s.io/v2",
+			path:        k8s.NewIngressPath(k8s.IngressApiVersionV1, "/", "banana", "http"),
+			expectError: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ingress := k8s.Ingress{
+				ApiVersion: tt.apiVersion,
+				Kind:       "Ingress",
+				Spec: k8s.IngressSpec{
+					Rules: []k8s.IngressRule{{Host: "example.com", Http: k8s.IngressHttp{Paths: []k8s.IngressPath{tt.path}}}},
+				},
+			}
+			err := ingress.Validate(context.Background())
+			if (err != nil) != tt.expectError {
+				t.Errorf("expect error %v but got %v", tt.expectError, err)
+			}
+		})
+	}
+}
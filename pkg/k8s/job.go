@@ -0,0 +1,131 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package k8s
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+
+	"github.com/bborbe/world/pkg/validation"
+	"github.com/bborbe/world/pkg/world"
+)
+
+type JobConfiguration struct {
+	Context      Context
+	Overlays     Overlays
+	Job          Job
+	Requirements []world.Configuration
+}
+
+func (c *JobConfiguration) Validate(ctx context.Context) error {
+	return validation.Validate(
+		ctx,
+		c.Context,
+		c.Job,
+	)
+}
+
+func (c *JobConfiguration) Applier() (world.Applier, error) {
+	return &JobApplier{
+		Context:  c.Context,
+		Overlays: c.Overlays,
+		Job:      c.Job,
+	}, nil
+}
+
+func (c *JobConfiguration) Children(ctx context.Context) (world.Configurations, error) {
+	return c.Requirements, nil
+}
+
+type JobApplier struct {
+	Context  Context
+	Overlays Overlays
+	Job      Job
+}
+
+func (s *JobApplier) KubernetesContext() Context {
+	return s.Context
+}
+
+func (s *JobApplier) Satisfied(ctx context.Context) (bool, error) {
+	deployer := &Deployer{
+		Context:  s.Context,
+		Overlays: s.Overlays,
+		Data:     s.Job,
+	}
+	return deployer.Satisfied(ctx)
+}
+
+// Apply recreates the Job, because the pod template of an existing Job can not be changed.
+func (s *JobApplier) Apply(ctx context.Context) error {
+	deployer := &Deployer{
+		Context:  s.Context,
+		Overlays: s.Overlays,
+		Data:     s.Job,
+	}
+	return deployer.Recreate(ctx)
+}
+
+func (s *JobApplier) Validate(ctx context.Context) error {
+	return validation.Validate(
+		ctx,
+		s.Context,
+		s.Overlays,
+		s.Job,
+	)
+}
+
+type Job struct {
+	ApiVersion ApiVersion `yaml:"apiVersion"`
+	Kind       Kind       `yaml:"kind"`
+	Metadata   Metadata   `yaml:"metadata"`
+	Spec       JobSpec    `yaml:"spec"`
+}
+
+func (s Job) String() string {
+	return fmt.Sprintf("%s/%s to %s", s.Kind, s.Metadata.Name, s.Metadata.Namespace)
+}
+
+func (s Job) Validate(ctx context.Context) error {
+	if s.ApiVersion != "batch/v1" {
+		return errors.New("invalid ApiVersion")
+	}
+	if s.Kind != "Job" {
+		return errors.New("invalid Kind")
+	}
+	return validation.Validate(
+		ctx,
+		s.Metadata,
+		s.Spec,
+	)
+}
+
+type JobSpec struct {
+	Parallelism             int         `yaml:"parallelism,omitempty"`
+	Completions             int         `yaml:"completions,omitempty"`
+	BackoffLimit            *int        `yaml:"backoffLimit,omitempty"`
+	ActiveDeadlineSeconds   int         `yaml:"activeDeadlineSeconds,omitempty"`
+	TTLSecondsAfterFinished int         `yaml:"ttlSecondsAfterFinished,omitempty"`
+	Template                PodTemplate `yaml:"template"`
+}
+
+func (j JobSpec) Validate(ctx context.Context) error {
+	switch j.Template.Spec.RestartPolicy {
+	case RestartPolicyOnFailure, RestartPolicyNever:
+	default:
+		return errors.Errorf("invalid restartPolicy '%s' for job", j.Template.Spec.RestartPolicy)
+	}
+	return validation.Validate(
+		ctx,
+		j.Template,
+	)
+}
+
+type JobTemplate struct {
+	Metadata Metadata `yaml:"metadata,omitempty"`
+	Spec     JobSpec  `yaml:"spec"`
+}
@@ -12,6 +12,10 @@ import (
 
 type Kind string
 
+func (k Kind) String() string {
+	return string(k)
+}
+
 func (k Kind) Validate(ctx context.Context) error {
 	if k == "" {
 		return errors.New("Kind missing")
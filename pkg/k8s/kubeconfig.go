@@ -0,0 +1,147 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package k8s
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/pkg/errors"
+	"gopkg.in/yaml.v2"
+)
+
+// ErrUnsupportedKubeconfig is returned for kubeconfigs only kubectl can handle, like exec or auth-provider plugins.
+var ErrUnsupportedKubeconfig = errors.New("kubeconfig not supported")
+
+type KubeconfigCluster struct {
+	Server                   string `yaml:"server"`
+	CertificateAuthority     string `yaml:"certificate-authority,omitempty"`
+	CertificateAuthorityData string `yaml:"certificate-authority-data,omitempty"`
+	InsecureSkipTLSVerify    bool   `yaml:"insecure-skip-tls-verify,omitempty"`
+}
+
+type KubeconfigUser struct {
+	ClientCertificate     string      `yaml:"client-certificate,omitempty"`
+	ClientCertificateData string      `yaml:"client-certificate-data,omitempty"`
+	ClientKey             string      `yaml:"client-key,omitempty"`
+	ClientKeyData         string      `yaml:"client-key-data,omitempty"`
+	Token                 string      `yaml:"token,omitempty"`
+	TokenFile             string      `yaml:"tokenFile,omitempty"`
+	Username              string      `yaml:"username,omitempty"`
+	Password              string      `yaml:"password,omitempty"`
+	Exec                  interface{} `yaml:"exec,omitempty"`
+	AuthProvider          interface{} `yaml:"auth-provider,omitempty"`
+}
+
+type KubeconfigContext struct {
+	Cluster   string `yaml:"cluster"`
+	User      string `yaml:"user"`
+	Namespace string `yaml:"namespace,omitempty"`
+}
+
+type Kubeconfig struct {
+	CurrentContext string `yaml:"current-context"`
+	Clusters       []struct {
+		Name    string            `yaml:"name"`
+		Cluster KubeconfigCluster `yaml:"cluster"`
+	} `yaml:"clusters"`
+	Users []struct {
+		Name string         `yaml:"name"`
+		User KubeconfigUser `yaml:"user"`
+	} `yaml:"users"`
+	Contexts []struct {
+		Name    string            `yaml:"name"`
+		Context KubeconfigContext `yaml:"context"`
+	} `yaml:"contexts"`
+}
+
+// KubeconfigEntry is the cluster and user of a single context.
+type KubeconfigEntry struct {
+	Cluster   KubeconfigCluster
+	User      KubeconfigUser
+	Namespace string
+}
+
+// KubeconfigPaths returns the files of $KUBECONFIG or ~/.kube/config.
+func KubeconfigPaths() []string {
+	if value := os.Getenv("KUBECONFIG"); value != "" {
+		return filepath.SplitList(value)
+	}
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return nil
+	}
+	return []string{filepath.Join(home, ".kube", "config")}
+}
+
+// LoadKubeconfigEntry finds the context in the given kubeconfig files.
+// Like kubectl the first file defining a name wins.
+func LoadKubeconfigEntry(context Context, paths ...string) (*KubeconfigEntry, error) {
+	clusters := map[string]KubeconfigCluster{}
+	users := map[string]KubeconfigUser{}
+	var found *KubeconfigContext
+	for _, path := range paths {
+		content, err := ioutil.ReadFile(path)
+		if os.IsNotExist(err) {
+			continue
+		}
+		if err != nil {
+			return nil, errors.Wrapf(err, "read kubeconfig %s failed", path)
+		}
+		var kubeconfig Kubeconfig
+		if err := yaml.Unmarshal(content, &kubeconfig); err != nil {
+			return nil, errors.Wrapf(err, "parse kubeconfig %s failed", path)
+		}
+		dir := filepath.Dir(path)
+		for _, cluster := range kubeconfig.Clusters {
+			if _, ok := clusters[cluster.Name]; !ok {
+				cluster.Cluster.CertificateAuthority = resolvePath(dir, cluster.Cluster.CertificateAuthority)
+				clusters[cluster.Name] = cluster.Cluster
+			}
+		}
+		for _, user := range kubeconfig.Users {
+			if _, ok := users[user.Name]; !ok {
+				user.User.ClientCertificate = resolvePath(dir, user.User.ClientCertificate)
+				user.User.ClientKey = resolvePath(dir, user.User.ClientKey)
+				user.User.TokenFile = resolvePath(dir, user.User.TokenFile)
+				users[user.Name] = user.User
+			}
+		}
+		for _, kubeContext := range kubeconfig.Contexts {
+			if found == nil && kubeContext.Name == context.String() {
+				kubeContext := kubeContext.Context
+				found = &kubeContext
+			}
+		}
+	}
+	if found == nil {
+		return nil, errors.Errorf("context %s not found in kubeconfig %s", context, strings.Join(paths, string(filepath.ListSeparator)))
+	}
+	cluster, ok := clusters[found.Cluster]
+	if !ok {
+		return nil, errors.Errorf("cluster %s of context %s not found", found.Cluster, context)
+	}
+	user, ok := users[found.User]
+	if !ok {
+		return nil, errors.Errorf("user %s of context %s not found", found.User, context)
+	}
+	if user.Exec != nil || user.AuthProvider != nil {
+		return nil, errors.Wrapf(ErrUnsupportedKubeconfig, "user %s of context %s uses auth plugin", found.User, context)
+	}
+	return &KubeconfigEntry{
+		Cluster:   cluster,
+		User:      user,
+		Namespace: found.Namespace,
+	}, nil
+}
+
+func resolvePath(dir string, path string) string {
+	if path == "" || filepath.IsAbs(path) {
+		return path
+	}
+	return filepath.Join(dir, path)
+}
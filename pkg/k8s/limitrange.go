@@ -0,0 +1,151 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package k8s
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+
+	"github.com/bborbe/world/pkg/validation"
+	"github.com/bborbe/world/pkg/world"
+)
+
+type LimitRangeConfiguration struct {
+	Context      Context
+	Overlays     Overlays
+	LimitRange   LimitRange
+	Requirements []world.Configuration
+}
+
+func (c *LimitRangeConfiguration) Validate(ctx context.Context) error {
+	return validation.Validate(
+		ctx,
+		c.Context,
+		c.LimitRange,
+	)
+}
+
+func (c *LimitRangeConfiguration) Applier() (world.Applier, error) {
+	return &LimitRangeApplier{
+		Context:    c.Context,
+		Overlays:   c.Overlays,
+		LimitRange: c.LimitRange,
+	}, nil
+}
+
+func (c *LimitRangeConfiguration) Children(ctx context.Context) (world.Configurations, error) {
+	return c.Requirements, nil
+}
+
+type LimitRangeApplier struct {
+	Context    Context
+	Overlays   Overlays
+	LimitRange LimitRange
+}
+
+func (s *LimitRangeApplier) KubernetesContext() Context {
+	return s.Context
+}
+
+func (s *LimitRangeApplier) Satisfied(ctx context.Context) (bool, error) {
+	deployer := &Deployer{
+		Context:  s.Context,
+		Overlays: s.Overlays,
+		Data:     s.LimitRange,
+	}
+	return deployer.Satisfied(ctx)
+}
+
+func (s *LimitRangeApplier) Apply(ctx context.Context) error {
+	deployer := &Deployer{
+		Context:  s.Context,
+		Overlays: s.Overlays,
+		Data:     s.LimitRange,
+	}
+	return deployer.Apply(ctx)
+}
+
+func (s *LimitRangeApplier) Validate(ctx context.Context) error {
+	return validation.Validate(
+		ctx,
+		s.Context,
+		s.Overlays,
+		s.LimitRange,
+	)
+}
+
+type LimitRange struct {
+	ApiVersion ApiVersion     `yaml:"apiVersion"`
+	Kind       Kind           `yaml:"kind"`
+	Metadata   Metadata       `yaml:"metadata"`
+	Spec       LimitRangeSpec `yaml:"spec"`
+}
+
+func (s LimitRange) String() string {
+	return fmt.Sprintf("%s/%s to %s", s.Kind, s.Metadata.Name, s.Metadata.Namespace)
+}
+
+func (s LimitRange) Validate(ctx context.Context) error {
+	if s.ApiVersion != "v1" {
+		return errors.New("invalid ApiVersion")
+	}
+	if s.Kind != "LimitRange" {
+		return errors.New("invalid Kind")
+	}
+	return validation.Validate(
+		ctx,
+		s.Metadata,
+		s.Spec,
+	)
+}
+
+type LimitType string
+
+const (
+	LimitTypeContainer             LimitType = "Container"
+	LimitTypePod                   LimitType = "Pod"
+	LimitTypePersistentVolumeClaim LimitType = "PersistentVolumeClaim"
+)
+
+type LimitRangeSpec struct {
+	Limits []LimitRangeItem `yaml:"limits"`
+}
+
+func (l LimitRangeSpec) Validate(ctx context.Context) error {
+	if len(l.Limits) == 0 {
+		return errors.New("limits missing")
+	}
+	for _, limit := range l.Limits {
+		if err := limit.Validate(ctx); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+type LimitRangeItem struct {
+	Type           LimitType    `yaml:"type"`
+	Default        ResourceList `yaml:"default,omitempty"`
+	DefaultRequest ResourceList `yaml:"defaultRequest,omitempty"`
+	Max            ResourceList `yaml:"max,omitempty"`
+	Min            ResourceList `yaml:"min,omitempty"`
+}
+
+func (l LimitRangeItem) Validate(ctx context.Context) error {
+	switch l.Type {
+	case LimitTypeContainer, LimitTypePod, LimitTypePersistentVolumeClaim:
+	default:
+		return errors.Errorf("invalid limit type '%s'", l.Type)
+	}
+	return validation.Validate(
+		ctx,
+		l.Default,
+		l.DefaultRequest,
+		l.Max,
+		l.Min,
+	)
+}
@@ -16,6 +16,7 @@ import (
 
 type NamespaceConfiguration struct {
 	Context      Context
+	Overlays     Overlays
 	Namespace    Namespace
 	Requirements []world.Configuration
 }
@@ -31,6 +32,7 @@ func (d *NamespaceConfiguration) Validate(ctx context.Context) error {
 func (d *NamespaceConfiguration) Applier() (world.Applier, error) {
 	return &NamespaceApplier{
 		Context:   d.Context,
+		Overlays:  d.Overlays,
 		Namespace: d.Namespace,
 	}, nil
 }
@@ -54,17 +56,28 @@ func (a NamespaceName) Validate(ctx context.Context) error {
 
 type NamespaceApplier struct {
 	Context   Context
+	Overlays  Overlays
 	Namespace Namespace
 }
 
+func (s *NamespaceApplier) KubernetesContext() Context {
+	return s.Context
+}
+
 func (s *NamespaceApplier) Satisfied(ctx context.Context) (bool, error) {
-	return false, nil
+	deployer := &Deployer{
+		Context:  s.Context,
+		Overlays: s.Overlays,
+		Data:     s.Namespace,
+	}
+	return deployer.Satisfied(ctx)
 }
 
 func (s *NamespaceApplier) Apply(ctx context.Context) error {
 	deployer := &Deployer{
-		Context: s.Context,
-		Data:    s.Namespace,
+		Context:  s.Context,
+		Overlays: s.Overlays,
+		Data:     s.Namespace,
 	}
 	return deployer.Apply(ctx)
 }
@@ -73,6 +86,9 @@ func (s *NamespaceApplier) Validate(ctx context.Context) error {
 	if s.Context == "" {
 		return errors.New("context missing")
 	}
+	if err := s.Overlays.Validate(ctx); err != nil {
+		return err
+	}
 	return s.Namespace.Validate(ctx)
 }
 
@@ -0,0 +1,161 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package k8s
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+
+	"github.com/bborbe/world/pkg/validation"
+	"github.com/bborbe/world/pkg/world"
+)
+
+type NetworkPolicyConfiguration struct {
+	Context       Context
+	Overlays      Overlays
+	NetworkPolicy NetworkPolicy
+	Requirements  []world.Configuration
+}
+
+func (c *NetworkPolicyConfiguration) Validate(ctx context.Context) error {
+	return validation.Validate(
+		ctx,
+		c.Context,
+		c.NetworkPolicy,
+	)
+}
+
+func (c *NetworkPolicyConfiguration) Applier() (world.Applier, error) {
+	return &NetworkPolicyApplier{
+		Context:       c.Context,
+		Overlays:      c.Overlays,
+		NetworkPolicy: c.NetworkPolicy,
+	}, nil
+}
+
+func (c *NetworkPolicyConfiguration) Children(ctx context.Context) (world.Configurations, error) {
+	return c.Requirements, nil
+}
+
+type NetworkPolicyApplier struct {
+	Context       Context
+	Overlays      Overlays
+	NetworkPolicy NetworkPolicy
+}
+
+func (s *NetworkPolicyApplier) KubernetesContext() Context {
+	return s.Context
+}
+
+func (s *NetworkPolicyApplier) Satisfied(ctx context.Context) (bool, error) {
+	deployer := &Deployer{
+		Context:  s.Context,
+		Overlays: s.Overlays,
+		Data:     s.NetworkPolicy,
+	}
+	return deployer.Satisfied(ctx)
+}
+
+func (s *NetworkPolicyApplier) Apply(ctx context.Context) error {
+	deployer := &Deployer{
+		Context:  s.Context,
+		Overlays: s.Overlays,
+		Data:     s.NetworkPolicy,
+	}
+	return deployer.Apply(ctx)
+}
+
+func (s *NetworkPolicyApplier) Validate(ctx context.Context) error {
+	return validation.Validate(
+		ctx,
+		s.Context,
+		s.Overlays,
+		s.NetworkPolicy,
+	)
+}
+
+type NetworkPolicy struct {
+	ApiVersion ApiVersion        `yaml:"apiVersion"`
+	Kind       Kind              `yaml:"kind"`
+	Metadata   Metadata          `yaml:"metadata"`
+	Spec       NetworkPolicySpec `yaml:"spec"`
+}
+
+func (s NetworkPolicy) String() string {
+	return fmt.Sprintf("%s/%s to %s", s.Kind, s.Metadata.Name, s.Metadata.Namespace)
+}
+
+func (s NetworkPolicy) Validate(ctx context.Context) error {
+	if s.ApiVersion != "networking.k8s.io/v1" {
+		return errors.New("invalid ApiVersion")
+	}
+	if s.Kind != "NetworkPolicy" {
+		return errors.New("invalid Kind")
+	}
+	return validation.Validate(
+		ctx,
+		s.Metadata,
+		s.Spec,
+	)
+}
+
+type NetworkPolicyType string
+
+const (
+	NetworkPolicyTypeIngress NetworkPolicyType = "Ingress"
+	NetworkPolicyTypeEgress  NetworkPolicyType = "Egress"
+)
+
+func (n NetworkPolicyType) Validate(ctx context.Context) error {
+	if n != NetworkPolicyTypeIngress && n != NetworkPolicyTypeEgress {
+		return errors.Errorf("invalid policyType '%s'", n)
+	}
+	return nil
+}
+
+// NetworkPolicySpec selects all pods of the namespace with an empty PodSelector.
+type NetworkPolicySpec struct {
+	PodSelector LabelSelector              `yaml:"podSelector"`
+	PolicyTypes []NetworkPolicyType        `yaml:"policyTypes,omitempty"`
+	Ingress     []NetworkPolicyIngressRule `yaml:"ingress,omitempty"`
+	Egress      []NetworkPolicyEgressRule  `yaml:"egress,omitempty"`
+}
+
+func (n NetworkPolicySpec) Validate(ctx context.Context) error {
+	for _, policyType := range n.PolicyTypes {
+		if err := policyType.Validate(ctx); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+type NetworkPolicyIngressRule struct {
+	From  []NetworkPolicyPeer `yaml:"from,omitempty"`
+	Ports []NetworkPolicyPort `yaml:"ports,omitempty"`
+}
+
+type NetworkPolicyEgressRule struct {
+	To    []NetworkPolicyPeer `yaml:"to,omitempty"`
+	Ports []NetworkPolicyPort `yaml:"ports,omitempty"`
+}
+
+type NetworkPolicyPeer struct {
+	PodSelector       *LabelSelector `yaml:"podSelector,omitempty"`
+	NamespaceSelector *LabelSelector `yaml:"namespaceSelector,omitempty"`
+	IPBlock           *IPBlock       `yaml:"ipBlock,omitempty"`
+}
+
+type IPBlock struct {
+	CIDR   string   `yaml:"cidr"`
+	Except []string `yaml:"except,omitempty"`
+}
+
+type NetworkPolicyPort struct {
+	Protocol PortProtocol `yaml:"protocol,omitempty"`
+	Port     IntOrString  `yaml:"port,omitempty"`
+}
@@ -0,0 +1,427 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package k8s
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+	"gopkg.in/yaml.v2"
+)
+
+// Overlay changes the objects of a cluster before they are applied.
+// Patches are applied first, followed by name prefix, labels, annotations and images.
+type Overlay struct {
+	// Target selects the objects of the overlay, all objects if empty.
+	Target OverlayTarget
+	// StrategicMergePatch in yaml is merged into the object. Lists of maps are merged by name,
+	// other lists are replaced and null removes the key.
+	StrategicMergePatch string
+	// JSONPatch in yaml or json with the operations add, remove, replace and test.
+	JSONPatch string
+	// NamePrefix is added to the name. References to other objects are not changed.
+	NamePrefix string
+	// CommonLabels are added to the object and its pod template, selectors are not changed.
+	CommonLabels Labels
+	// CommonAnnotations are added to the object.
+	CommonAnnotations Annotations
+	Images            []ImageOverride
+}
+
+// OverlayTarget matches all objects with the given fields, empty fields match all.
+type OverlayTarget struct {
+	Kind      Kind
+	Namespace NamespaceName
+	Name      MetadataName
+}
+
+// ImageOverride replaces the name and or tag of all images with the name, like bborbe/backup.
+type ImageOverride struct {
+	Name    string
+	NewName string
+	NewTag  string
+}
+
+func (o Overlay) Validate(ctx context.Context) error {
+	if o.StrategicMergePatch != "" {
+		var patch yaml.MapSlice
+		if err := yaml.Unmarshal([]byte(o.StrategicMergePatch), &patch); err != nil {
+			return errors.Wrap(err, "parse strategicMergePatch failed")
+		}
+	}
+	if o.JSONPatch != "" {
+		if _, err := parseJSONPatch(o.JSONPatch); err != nil {
+			return err
+		}
+	}
+	for _, image := range o.Images {
+		if image.Name == "" {
+			return errors.New("image name missing")
+		}
+	}
+	return nil
+}
+
+// Overlays are applied in order.
+type Overlays []Overlay
+
+func (o Overlays) Validate(ctx context.Context) error {
+	for i, overlay := range o {
+		if err := overlay.Validate(ctx); err != nil {
+			return errors.Wrapf(err, "validate overlay %d failed", i)
+		}
+	}
+	return nil
+}
+
+// ApplyOverlays to the yaml object.
+func ApplyOverlays(data []byte, list ...Overlay) ([]byte, error) {
+	if len(list) == 0 {
+		return data, nil
+	}
+	var object yaml.MapSlice
+	if err := yaml.Unmarshal(data, &object); err != nil {
+		return nil, errors.Wrap(err, "parse object failed")
+	}
+	for _, overlay := range list {
+		var err error
+		object, err = overlay.apply(object)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return yaml.Marshal(object)
+}
+
+func (o Overlay) apply(object yaml.MapSlice) (yaml.MapSlice, error) {
+	metadata := mapSliceGet(object, "metadata")
+	if !o.Target.matches(object, metadata) {
+		return object, nil
+	}
+	if o.StrategicMergePatch != "" {
+		var patch yaml.MapSlice
+		if err := yaml.Unmarshal([]byte(o.StrategicMergePatch), &patch); err != nil {
+			return nil, errors.Wrap(err, "parse strategicMergePatch failed")
+		}
+		object = strategicMerge(object, patch)
+	}
+	if o.JSONPatch != "" {
+		operations, err := parseJSONPatch(o.JSONPatch)
+		if err != nil {
+			return nil, err
+		}
+		var value interface{} = object
+		for _, operation := range operations {
+			value, err = operation.apply(value)
+			if err != nil {
+				return nil, err
+			}
+		}
+		var ok bool
+		if object, ok = value.(yaml.MapSlice); !ok {
+			return nil, errors.New("jsonPatch result is not an object")
+		}
+	}
+	metadata = mapSliceGet(object, "metadata")
+	if o.NamePrefix != "" {
+		if name, ok := mapSliceValue(metadata, "name").(string); ok {
+			metadata = mapSliceSet(metadata, "name", o.NamePrefix+name)
+		}
+	}
+	if len(o.CommonLabels) > 0 {
+		metadata = addStrings(metadata, "labels", o.CommonLabels)
+		object = mapSliceSet(object, "spec", addTemplateLabels(mapSliceGet(object, "spec"), o.CommonLabels))
+	}
+	if len(o.CommonAnnotations) > 0 {
+		metadata = addStrings(metadata, "annotations", o.CommonAnnotations)
+	}
+	object = mapSliceSet(object, "metadata", metadata)
+	if len(o.Images) > 0 {
+		object = replaceImages(object, o.Images).(yaml.MapSlice)
+	}
+	return object, nil
+}
+
+func (t OverlayTarget) matches(object yaml.MapSlice, metadata yaml.MapSlice) bool {
+	if t.Kind != "" && mapSliceValue(object, "kind") != t.Kind.String() {
+		return false
+	}
+	if t.Namespace != "" && mapSliceValue(metadata, "namespace") != t.Namespace.String() {
+		return false
+	}
+	if t.Name != "" && mapSliceValue(metadata, "name") != t.Name.String() {
+		return false
+	}
+	return true
+}
+
+func addStrings(slice yaml.MapSlice, key string, values map[string]string) yaml.MapSlice {
+	keys := make([]string, 0, len(values))
+	for k := range values {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	target := mapSliceGet(slice, key)
+	for _, k := range keys {
+		target = mapSliceSet(target, k, values[k])
+	}
+	return mapSliceSet(slice, key, target)
+}
+
+// addTemplateLabels adds the labels to the pod template of workloads and jobs of cronjobs.
+func addTemplateLabels(spec yaml.MapSlice, labels map[string]string) yaml.MapSlice {
+	if spec == nil {
+		return nil
+	}
+	if template := mapSliceGet(spec, "template"); template != nil {
+		template = mapSliceSet(template, "metadata", addStrings(mapSliceGet(template, "metadata"), "labels", labels))
+		spec = mapSliceSet(spec, "template", template)
+	}
+	if jobTemplate := mapSliceGet(spec, "jobTemplate"); jobTemplate != nil {
+		jobTemplate = mapSliceSet(jobTemplate, "metadata", addStrings(mapSliceGet(jobTemplate, "metadata"), "labels", labels))
+		jobTemplate = mapSliceSet(jobTemplate, "spec", addTemplateLabels(mapSliceGet(jobTemplate, "spec"), labels))
+		spec = mapSliceSet(spec, "jobTemplate", jobTemplate)
+	}
+	return spec
+}
+
+// replaceImages walks the object and replaces the image of all containers and initContainers.
+func replaceImages(value interface{}, images []ImageOverride) interface{} {
+	switch v := value.(type) {
+	case yaml.MapSlice:
+		result := yaml.MapSlice{}
+		for _, item := range v {
+			if key, ok := item.Key.(string); ok && (key == "containers" || key == "initContainers") {
+				if containers, ok := item.Value.([]interface{}); ok {
+					var replaced []interface{}
+					for _, container := range containers {
+						replaced = append(replaced, replaceContainerImage(container, images))
+					}
+					result = append(result, yaml.MapItem{Key: item.Key, Value: replaced})
+					continue
+				}
+			}
+			result = append(result, yaml.MapItem{Key: item.Key, Value: replaceImages(item.Value, images)})
+		}
+		return result
+	case []interface{}:
+		var result []interface{}
+		for _, item := range v {
+			result = append(result, replaceImages(item, images))
+		}
+		return result
+	default:
+		return value
+	}
+}
+
+func replaceContainerImage(container interface{}, images []ImageOverride) interface{} {
+	slice, ok := container.(yaml.MapSlice)
+	if !ok {
+		return container
+	}
+	image, ok := mapSliceValue(slice, "image").(string)
+	if !ok {
+		return container
+	}
+	name, tag := splitImage(image)
+	for _, override := range images {
+		if override.Name != name {
+			continue
+		}
+		if override.NewName != "" {
+			name = override.NewName
+		}
+		if override.NewTag != "" {
+			tag = override.NewTag
+		}
+		if tag == "" {
+			return mapSliceSet(slice, "image", name)
+		}
+		return mapSliceSet(slice, "image", fmt.Sprintf("%s:%s", name, tag))
+	}
+	return container
+}
+
+// splitImage returns name and tag of the image, the registry port is part of the name.
+func splitImage(image string) (string, string) {
+	if pos := strings.Index(image, "@"); pos != -1 {
+		image = image[:pos]
+	}
+	pos := strings.LastIndex(image, ":")
+	if pos == -1 || strings.Contains(image[pos:], "/") {
+		return image, ""
+	}
+	return image[:pos], image[pos+1:]
+}
+
+// strategicMerge merges the patch into the object. Lists of maps with name are merged by name.
+func strategicMerge(object yaml.MapSlice, patch yaml.MapSlice) yaml.MapSlice {
+	result := append(yaml.MapSlice{}, object...)
+	for _, item := range patch {
+		key, _ := item.Key.(string)
+		if item.Value == nil {
+			result = mapSliceDelete(result, key)
+			continue
+		}
+		current := mapSliceValue(result, key)
+		result = mapSliceSet(result, key, mergeValue(current, item.Value))
+	}
+	return result
+}
+
+func mergeValue(current interface{}, patch interface{}) interface{} {
+	switch p := patch.(type) {
+	case yaml.MapSlice:
+		if c, ok := current.(yaml.MapSlice); ok {
+			return strategicMerge(c, p)
+		}
+		return p
+	case []interface{}:
+		c, ok := current.([]interface{})
+		if !ok || !namedList(c) || !namedList(p) {
+			return p
+		}
+		result := append([]interface{}{}, c...)
+		for _, patchItem := range p {
+			patchMap := patchItem.(yaml.MapSlice)
+			found := false
+			for i, currentItem := range result {
+				currentMap := currentItem.(yaml.MapSlice)
+				if mapSliceValue(currentMap, "name") == mapSliceValue(patchMap, "name") {
+					result[i] = strategicMerge(currentMap, patchMap)
+					found = true
+					break
+				}
+			}
+			if !found {
+				result = append(result, patchMap)
+			}
+		}
+		return result
+	default:
+		return patch
+	}
+}
+
+func namedList(list []interface{}) bool {
+	for _, item := range list {
+		slice, ok := item.(yaml.MapSlice)
+		if !ok || mapSliceValue(slice, "name") == nil {
+			return false
+		}
+	}
+	return true
+}
+
+type jsonPatchOperation struct {
+	Op    string      `yaml:"op"`
+	Path  string      `yaml:"path"`
+	Value interface{} `yaml:"value"`
+}
+
+func parseJSONPatch(content string) ([]jsonPatchOperation, error) {
+	var operations []jsonPatchOperation
+	if err := yaml.Unmarshal([]byte(content), &operations); err != nil {
+		return nil, errors.Wrap(err, "parse jsonPatch failed")
+	}
+	for _, operation := range operations {
+		switch operation.Op {
+		case "add", "remove", "replace", "test":
+		default:
+			return nil, errors.Errorf("jsonPatch operation '%s' not supported", operation.Op)
+		}
+		if !strings.HasPrefix(operation.Path, "/") {
+			return nil, errors.Errorf("invalid jsonPatch path '%s'", operation.Path)
+		}
+	}
+	return operations, nil
+}
+
+func (j jsonPatchOperation) apply(document interface{}) (interface{}, error) {
+	var tokens []string
+	for _, token := range strings.Split(j.Path, "/")[1:] {
+		tokens = append(tokens, strings.Replace(strings.Replace(token, "~1", "/", -1), "~0", "~", -1))
+	}
+	result, err := j.applyTokens(document, tokens)
+	return result, errors.Wrapf(err, "jsonPatch %s %s failed", j.Op, j.Path)
+}
+
+func (j jsonPatchOperation) applyTokens(value interface{}, tokens []string) (interface{}, error) {
+	token := tokens[0]
+	last := len(tokens) == 1
+	switch v := value.(type) {
+	case yaml.MapSlice:
+		current := mapSliceValue(v, token)
+		exists := mapSliceHas(v, token)
+		if !last {
+			if !exists {
+				return nil, errors.Errorf("path %s not found", token)
+			}
+			child, err := j.applyTokens(current, tokens[1:])
+			if err != nil {
+				return nil, err
+			}
+			return mapSliceSet(v, token, child), nil
+		}
+		switch j.Op {
+		case "add":
+			return mapSliceSet(v, token, j.Value), nil
+		case "replace":
+			if !exists {
+				return nil, errors.Errorf("path %s not found", token)
+			}
+			return mapSliceSet(v, token, j.Value), nil
+		case "remove":
+			if !exists {
+				return nil, errors.Errorf("path %s not found", token)
+			}
+			return mapSliceDelete(v, token), nil
+		default:
+			if fmt.Sprint(current) != fmt.Sprint(j.Value) {
+				return nil, errors.Errorf("test of %s failed", token)
+			}
+			return v, nil
+		}
+	case []interface{}:
+		if last && j.Op == "add" && token == "-" {
+			return append(append([]interface{}{}, v...), j.Value), nil
+		}
+		index, err := strconv.Atoi(token)
+		if err != nil || index < 0 || index > len(v) || (index == len(v) && !(last && j.Op == "add")) {
+			return nil, errors.Errorf("invalid index %s", token)
+		}
+		result := append([]interface{}{}, v...)
+		if !last {
+			child, err := j.applyTokens(v[index], tokens[1:])
+			if err != nil {
+				return nil, err
+			}
+			result[index] = child
+			return result, nil
+		}
+		switch j.Op {
+		case "add":
+			result = append(result[:index], append([]interface{}{j.Value}, v[index:]...)...)
+			return result, nil
+		case "replace":
+			result[index] = j.Value
+			return result, nil
+		case "remove":
+			return append(result[:index], result[index+1:]...), nil
+		default:
+			if fmt.Sprint(v[index]) != fmt.Sprint(j.Value) {
+				return nil, errors.Errorf("test of %s failed", token)
+			}
+			return v, nil
+		}
+	default:
+		return nil, errors.Errorf("path %s not found", token)
+	}
+}
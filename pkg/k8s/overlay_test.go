@@ -0,0 +1,157 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package k8s_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/bborbe/world/pkg/k8s"
+)
+
+var _ = Describe("Overlay", func() {
+	var object []byte
+	BeforeEach(func() {
+		object = []byte(`apiVersion: apps/v1
+kind: Deployment
+metadata:
+  namespace: fruits
+  name: banana
+spec:
+  replicas: 1
+  template:
+    spec:
+      containers:
+      - name: banana
+        image: docker.io/bborbe/banana:1.0.0
+        args:
+        - -v=1
+      - name: sidecar
+        image: localhost:5000/sidecar
+`)
+	})
+	It("returns the object unchanged without overlays", func() {
+		result, err := k8s.ApplyOverlays(object)
+		Expect(err).To(BeNil())
+		Expect(result).To(Equal(object))
+	})
+	It("adds prefix, labels and annotations", func() {
+		result, err := k8s.ApplyOverlays(object, k8s.Overlay{
+			NamePrefix:        "dev-",
+			CommonLabels:      k8s.Labels{"stage": "dev"},
+			CommonAnnotations: k8s.Annotations{"owner": "bborbe"},
+		})
+		Expect(err).To(BeNil())
+		Expect(string(result)).To(Equal(`apiVersion: apps/v1
+kind: Deployment
+metadata:
+  namespace: fruits
+  name: dev-banana
+  labels:
+    stage: dev
+  annotations:
+    owner: bborbe
+spec:
+  replicas: 1
+  template:
+    spec:
+      containers:
+      - name: banana
+        image: docker.io/bborbe/banana:1.0.0
+        args:
+        - -v=1
+      - name: sidecar
+        image: localhost:5000/sidecar
+    metadata:
+      labels:
+        stage: dev
+`))
+	})
+	It("replaces images", func() {
+		result, err := k8s.ApplyOverlays(object, k8s.Overlay{
+			Images: []k8s.ImageOverride{
+				{Name: "docker.io/bborbe/banana", NewTag: "2.0.0"},
+				{Name: "localhost:5000/sidecar", NewName: "registry/sidecar", NewTag: "1.1"},
+			},
+		})
+		Expect(err).To(BeNil())
+		Expect(string(result)).To(ContainSubstring("image: docker.io/bborbe/banana:2.0.0"))
+		Expect(string(result)).To(ContainSubstring("image: registry/sidecar:1.1"))
+	})
+	It("merges containers by name", func() {
+		result, err := k8s.ApplyOverlays(object, k8s.Overlay{
+			StrategicMergePatch: `
+spec:
+  replicas: 3
+  template:
+    spec:
+      containers:
+      - name: banana
+        args:
+        - -v=4
+      - name: apple
+        image: apple
+`,
+		})
+		Expect(err).To(BeNil())
+		Expect(string(result)).To(ContainSubstring(`spec:
+  replicas: 3
+  template:
+    spec:
+      containers:
+      - name: banana
+        image: docker.io/bborbe/banana:1.0.0
+        args:
+        - -v=4
+      - name: sidecar
+        image: localhost:5000/sidecar
+      - name: apple
+        image: apple
+`))
+	})
+	It("applies json patches", func() {
+		result, err := k8s.ApplyOverlays(object, k8s.Overlay{
+			JSONPatch: `[
+  {"op": "test", "path": "/spec/replicas", "value": 1},
+  {"op": "replace", "path": "/spec/replicas", "value": 2},
+  {"op": "remove", "path": "/spec/template/spec/containers/1"},
+  {"op": "add", "path": "/spec/template/spec/containers/0/args/-", "value": "-logtostderr"}
+]`,
+		})
+		Expect(err).To(BeNil())
+		Expect(string(result)).To(ContainSubstring("replicas: 2"))
+		Expect(string(result)).NotTo(ContainSubstring("sidecar"))
+		Expect(string(result)).To(ContainSubstring("- -v=1\n        - -logtostderr\n"))
+	})
+	It("returns error if json patch test fails", func() {
+		_, err := k8s.ApplyOverlays(object, k8s.Overlay{
+			JSONPatch: `[{"op": "test", "path": "/spec/replicas", "value": 5}]`,
+		})
+		Expect(err).NotTo(BeNil())
+	})
+	It("skips objects not matching the target", func() {
+		result, err := k8s.ApplyOverlays(object, k8s.Overlay{
+			Target:     k8s.OverlayTarget{Kind: "Deployment", Name: "apple"},
+			NamePrefix: "dev-",
+		})
+		Expect(err).To(BeNil())
+		Expect(string(result)).To(ContainSubstring("name: banana"))
+	})
+	It("validates unsupported json patch operations", func() {
+		overlay := k8s.Overlay{
+			JSONPatch: `[{"op": "move", "from": "/a", "path": "/b"}]`,
+		}
+		Expect(overlay.Validate(context.Background())).NotTo(BeNil())
+	})
+	It("validates all overlays", func() {
+		overlays := k8s.Overlays{
+			{NamePrefix: "dev-"},
+			{JSONPatch: `[{"op": "move", "from": "/a", "path": "/b"}]`},
+		}
+		Expect(overlays.Validate(context.Background())).NotTo(BeNil())
+	})
+})
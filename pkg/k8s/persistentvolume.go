@@ -0,0 +1,159 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package k8s
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+
+	"github.com/bborbe/world/pkg/validation"
+	"github.com/bborbe/world/pkg/world"
+)
+
+type PersistentVolumeConfiguration struct {
+	Context          Context
+	Overlays         Overlays
+	PersistentVolume PersistentVolume
+	Requirements     []world.Configuration
+}
+
+func (c *PersistentVolumeConfiguration) Validate(ctx context.Context) error {
+	return validation.Validate(
+		ctx,
+		c.Context,
+		c.PersistentVolume,
+	)
+}
+
+func (c *PersistentVolumeConfiguration) Applier() (world.Applier, error) {
+	return &PersistentVolumeApplier{
+		Context:          c.Context,
+		Overlays:         c.Overlays,
+		PersistentVolume: c.PersistentVolume,
+	}, nil
+}
+
+func (c *PersistentVolumeConfiguration) Children(ctx context.Context) (world.Configurations, error) {
+	return c.Requirements, nil
+}
+
+type PersistentVolumeApplier struct {
+	Context          Context
+	Overlays         Overlays
+	PersistentVolume PersistentVolume
+}
+
+func (s *PersistentVolumeApplier) KubernetesContext() Context {
+	return s.Context
+}
+
+func (s *PersistentVolumeApplier) Satisfied(ctx context.Context) (bool, error) {
+	deployer := &Deployer{
+		Context:  s.Context,
+		Overlays: s.Overlays,
+		Data:     s.PersistentVolume,
+	}
+	return deployer.Satisfied(ctx)
+}
+
+func (s *PersistentVolumeApplier) Apply(ctx context.Context) error {
+	deployer := &Deployer{
+		Context:  s.Context,
+		Overlays: s.Overlays,
+		Data:     s.PersistentVolume,
+	}
+	return deployer.Apply(ctx)
+}
+
+func (s *PersistentVolumeApplier) Validate(ctx context.Context) error {
+	return validation.Validate(
+		ctx,
+		s.Context,
+		s.Overlays,
+		s.PersistentVolume,
+	)
+}
+
+type PersistentVolume struct {
+	ApiVersion ApiVersion           `yaml:"apiVersion"`
+	Kind       Kind                 `yaml:"kind"`
+	Metadata   Metadata             `yaml:"metadata"`
+	Spec       PersistentVolumeSpec `yaml:"spec"`
+}
+
+func (s PersistentVolume) String() string {
+	return fmt.Sprintf("%s/%s", s.Kind, s.Metadata.Name)
+}
+
+func (s PersistentVolume) Validate(ctx context.Context) error {
+	if s.ApiVersion != "v1" {
+		return errors.New("invalid ApiVersion")
+	}
+	if s.Kind != "PersistentVolume" {
+		return errors.New("invalid Kind")
+	}
+	return validation.Validate(
+		ctx,
+		s.Metadata.Name,
+		s.Spec,
+	)
+}
+
+type PersistentVolumeReclaimPolicy string
+
+const (
+	PersistentVolumeReclaimPolicyRetain PersistentVolumeReclaimPolicy = "Retain"
+	PersistentVolumeReclaimPolicyDelete PersistentVolumeReclaimPolicy = "Delete"
+)
+
+type PersistentVolumeCapacity struct {
+	Storage Storage `yaml:"storage"`
+}
+
+// PersistentVolumeSpec requires exactly one of HostPath, Nfs or Local.
+type PersistentVolumeSpec struct {
+	Capacity                      PersistentVolumeCapacity      `yaml:"capacity"`
+	AccessModes                   []AccessMode                  `yaml:"accessModes"`
+	PersistentVolumeReclaimPolicy PersistentVolumeReclaimPolicy `yaml:"persistentVolumeReclaimPolicy,omitempty"`
+	StorageClassName              StorageClassName              `yaml:"storageClassName,omitempty"`
+	VolumeMode                    string                        `yaml:"volumeMode,omitempty"`
+	HostPath                      *PodVolumeHost                `yaml:"hostPath,omitempty"`
+	Nfs                           *PodVolumeNfs                 `yaml:"nfs,omitempty"`
+	Local                         *PersistentVolumeLocal        `yaml:"local,omitempty"`
+	NodeAffinity                  *VolumeNodeAffinity           `yaml:"nodeAffinity,omitempty"`
+}
+
+func (p PersistentVolumeSpec) Validate(ctx context.Context) error {
+	if len(p.AccessModes) == 0 {
+		return errors.New("accessModes missing")
+	}
+	sources := 0
+	if p.HostPath != nil {
+		sources++
+	}
+	if p.Nfs != nil {
+		sources++
+	}
+	if p.Local != nil {
+		sources++
+	}
+	if sources != 1 {
+		return errors.New("persistentVolume requires exactly one volume source")
+	}
+	return validation.Validate(
+		ctx,
+		p.Capacity.Storage,
+	)
+}
+
+type PersistentVolumeLocal struct {
+	Path PodHostPath `yaml:"path"`
+}
+
+type VolumeNodeAffinity struct {
+	Required NodeSelector `yaml:"required"`
+}
@@ -0,0 +1,127 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package k8s
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+
+	"github.com/bborbe/world/pkg/validation"
+	"github.com/bborbe/world/pkg/world"
+)
+
+type PersistentVolumeClaimConfiguration struct {
+	Context               Context
+	Overlays              Overlays
+	PersistentVolumeClaim PersistentVolumeClaim
+	Requirements          []world.Configuration
+}
+
+func (c *PersistentVolumeClaimConfiguration) Validate(ctx context.Context) error {
+	return validation.Validate(
+		ctx,
+		c.Context,
+		c.PersistentVolumeClaim,
+	)
+}
+
+func (c *PersistentVolumeClaimConfiguration) Applier() (world.Applier, error) {
+	return &PersistentVolumeClaimApplier{
+		Context:               c.Context,
+		Overlays:              c.Overlays,
+		PersistentVolumeClaim: c.PersistentVolumeClaim,
+	}, nil
+}
+
+func (c *PersistentVolumeClaimConfiguration) Children(ctx context.Context) (world.Configurations, error) {
+	return c.Requirements, nil
+}
+
+type PersistentVolumeClaimApplier struct {
+	Context               Context
+	Overlays              Overlays
+	PersistentVolumeClaim PersistentVolumeClaim
+}
+
+func (s *PersistentVolumeClaimApplier) KubernetesContext() Context {
+	return s.Context
+}
+
+func (s *PersistentVolumeClaimApplier) Satisfied(ctx context.Context) (bool, error) {
+	deployer := &Deployer{
+		Context:  s.Context,
+		Overlays: s.Overlays,
+		Data:     s.PersistentVolumeClaim,
+	}
+	return deployer.Satisfied(ctx)
+}
+
+func (s *PersistentVolumeClaimApplier) Apply(ctx context.Context) error {
+	deployer := &Deployer{
+		Context:  s.Context,
+		Overlays: s.Overlays,
+		Data:     s.PersistentVolumeClaim,
+	}
+	return deployer.Apply(ctx)
+}
+
+func (s *PersistentVolumeClaimApplier) Validate(ctx context.Context) error {
+	return validation.Validate(
+		ctx,
+		s.Context,
+		s.Overlays,
+		s.PersistentVolumeClaim,
+	)
+}
+
+type PersistentVolumeClaim struct {
+	ApiVersion ApiVersion                `yaml:"apiVersion"`
+	Kind       Kind                      `yaml:"kind"`
+	Metadata   Metadata                  `yaml:"metadata"`
+	Spec       PersistentVolumeClaimSpec `yaml:"spec"`
+}
+
+func (s PersistentVolumeClaim) String() string {
+	return fmt.Sprintf("%s/%s to %s", s.Kind, s.Metadata.Name, s.Metadata.Namespace)
+}
+
+func (s PersistentVolumeClaim) Validate(ctx context.Context) error {
+	if s.ApiVersion != "v1" {
+		return errors.New("invalid ApiVersion")
+	}
+	if s.Kind != "PersistentVolumeClaim" {
+		return errors.New("invalid Kind")
+	}
+	return validation.Validate(
+		ctx,
+		s.Metadata,
+		s.Spec,
+	)
+}
+
+type PersistentVolumeClaimSpec struct {
+	AccessModes      []AccessMode                      `yaml:"accessModes"`
+	StorageClassName StorageClassName                  `yaml:"storageClassName,omitempty"`
+	VolumeName       MetadataName                      `yaml:"volumeName,omitempty"`
+	Selector         *LabelSelector                    `yaml:"selector,omitempty"`
+	Resources        VolumeClaimTemplatesSpecResources `yaml:"resources"`
+}
+
+func (p PersistentVolumeClaimSpec) Validate(ctx context.Context) error {
+	if len(p.AccessModes) == 0 {
+		return errors.New("accessModes missing")
+	}
+	for _, accessMode := range p.AccessModes {
+		if err := accessMode.Validate(ctx); err != nil {
+			return err
+		}
+	}
+	return validation.Validate(
+		ctx,
+		p.Resources.Requests.Storage,
+	)
+}
@@ -32,6 +32,7 @@ type Toleration struct {
 
 type PodSpec struct {
 	Tolerations                   []Toleration    `yaml:"tolerations,omitempty"`
+	InitContainers                []Container     `yaml:"initContainers,omitempty"`
 	Containers                    []Container     `yaml:"containers,omitempty"`
 	Volumes                       []PodVolume     `yaml:"volumes,omitempty"`
 	HostNetwork                   PodHostNetwork  `yaml:"hostNetwork,omitempty"`
@@ -41,8 +42,17 @@ type PodSpec struct {
 	ServiceAccountName            string          `yaml:"serviceAccountName,omitempty"`
 	TerminationGracePeriodSeconds int             `yaml:"terminationGracePeriodSeconds,omitempty"`
 	Affinity                      Affinity        `yaml:"affinity,omitempty"`
+	RestartPolicy                 RestartPolicy   `yaml:"restartPolicy,omitempty"`
 }
 
+type RestartPolicy string
+
+const (
+	RestartPolicyAlways    RestartPolicy = "Always"
+	RestartPolicyOnFailure RestartPolicy = "OnFailure"
+	RestartPolicyNever     RestartPolicy = "Never"
+)
+
 type Affinity struct {
 	NodeAffinity    NodeAffinity    `yaml:"nodeAffinity,omitempty"`
 	PodAffinity     PodAffinity     `yaml:"podAffinity,omitempty"`
@@ -205,6 +215,16 @@ type ValueFrom struct {
 	ConfigMapKeyRef ConfigMapKeyRef `yaml:"configMapKeyRef,omitempty"`
 }
 
+// EnvFrom sets all keys of the ConfigMap or Secret as environment variables.
+type EnvFrom struct {
+	ConfigMapRef EnvFromRef `yaml:"configMapRef,omitempty"`
+	SecretRef    EnvFromRef `yaml:"secretRef,omitempty"`
+}
+
+type EnvFromRef struct {
+	Name string `yaml:"name"`
+}
+
 type SecretKeyRef struct {
 	Key  string `yaml:"key"`
 	Name string `yaml:"name"`
@@ -16,6 +16,7 @@ import (
 
 type PodDisruptionBudgetConfiguration struct {
 	Context             Context
+	Overlays            Overlays
 	PodDisruptionBudget PodDisruptionBudget
 	Requirements        []world.Configuration
 }
@@ -31,6 +32,7 @@ func (d *PodDisruptionBudgetConfiguration) Validate(ctx context.Context) error {
 func (d *PodDisruptionBudgetConfiguration) Applier() (world.Applier, error) {
 	return &PodDisruptionBudgetApplier{
 		Context:             d.Context,
+		Overlays:            d.Overlays,
 		PodDisruptionBudget: d.PodDisruptionBudget,
 	}, nil
 }
@@ -41,17 +43,28 @@ func (d *PodDisruptionBudgetConfiguration) Children(ctx context.Context) (world.
 
 type PodDisruptionBudgetApplier struct {
 	Context             Context
+	Overlays            Overlays
 	PodDisruptionBudget PodDisruptionBudget
 }
 
+func (s *PodDisruptionBudgetApplier) KubernetesContext() Context {
+	return s.Context
+}
+
 func (s *PodDisruptionBudgetApplier) Satisfied(ctx context.Context) (bool, error) {
-	return false, nil
+	deployer := &Deployer{
+		Context:  s.Context,
+		Overlays: s.Overlays,
+		Data:     s.PodDisruptionBudget,
+	}
+	return deployer.Satisfied(ctx)
 }
 
 func (s *PodDisruptionBudgetApplier) Apply(ctx context.Context) error {
 	deployer := &Deployer{
-		Context: s.Context,
-		Data:    s.PodDisruptionBudget,
+		Context:  s.Context,
+		Overlays: s.Overlays,
+		Data:     s.PodDisruptionBudget,
 	}
 	return deployer.Apply(ctx)
 }
@@ -60,6 +73,7 @@ func (s *PodDisruptionBudgetApplier) Validate(ctx context.Context) error {
 	return validation.Validate(
 		ctx,
 		s.Context,
+		s.Overlays,
 		s.PodDisruptionBudget,
 	)
 }
@@ -0,0 +1,127 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package k8s
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+
+	"github.com/bborbe/world/pkg/validation"
+	"github.com/bborbe/world/pkg/world"
+)
+
+type PodMonitorConfiguration struct {
+	Context      Context
+	Overlays     Overlays
+	PodMonitor   PodMonitor
+	Requirements []world.Configuration
+}
+
+func (c *PodMonitorConfiguration) Validate(ctx context.Context) error {
+	return validation.Validate(
+		ctx,
+		c.Context,
+		c.PodMonitor,
+	)
+}
+
+func (c *PodMonitorConfiguration) Applier() (world.Applier, error) {
+	return &PodMonitorApplier{
+		Context:    c.Context,
+		Overlays:   c.Overlays,
+		PodMonitor: c.PodMonitor,
+	}, nil
+}
+
+func (c *PodMonitorConfiguration) Children(ctx context.Context) (world.Configurations, error) {
+	return c.Requirements, nil
+}
+
+type PodMonitorApplier struct {
+	Context    Context
+	Overlays   Overlays
+	PodMonitor PodMonitor
+}
+
+func (s *PodMonitorApplier) KubernetesContext() Context {
+	return s.Context
+}
+
+func (s *PodMonitorApplier) Satisfied(ctx context.Context) (bool, error) {
+	deployer := &Deployer{
+		Context:  s.Context,
+		Overlays: s.Overlays,
+		Data:     s.PodMonitor,
+	}
+	return deployer.Satisfied(ctx)
+}
+
+func (s *PodMonitorApplier) Apply(ctx context.Context) error {
+	deployer := &Deployer{
+		Context:  s.Context,
+		Overlays: s.Overlays,
+		Data:     s.PodMonitor,
+	}
+	return deployer.Apply(ctx)
+}
+
+func (s *PodMonitorApplier) Validate(ctx context.Context) error {
+	return validation.Validate(
+		ctx,
+		s.Context,
+		s.Overlays,
+		s.PodMonitor,
+	)
+}
+
+type PodMonitor struct {
+	ApiVersion ApiVersion     `yaml:"apiVersion"`
+	Kind       Kind           `yaml:"kind"`
+	Metadata   Metadata       `yaml:"metadata"`
+	Spec       PodMonitorSpec `yaml:"spec"`
+}
+
+func (s PodMonitor) String() string {
+	return fmt.Sprintf("%s/%s to %s", s.Kind, s.Metadata.Name, s.Metadata.Namespace)
+}
+
+func (s PodMonitor) Validate(ctx context.Context) error {
+	if s.ApiVersion != "monitoring.coreos.com/v1" {
+		return errors.New("invalid ApiVersion")
+	}
+	if s.Kind != "PodMonitor" {
+		return errors.New("invalid Kind")
+	}
+	return validation.Validate(
+		ctx,
+		s.Metadata,
+		s.Spec,
+	)
+}
+
+// PodMonitorSpec of the prometheus-operator custom resource.
+type PodMonitorSpec struct {
+	JobLabel            string                   `yaml:"jobLabel,omitempty"`
+	Selector            LabelSelector            `yaml:"selector"`
+	NamespaceSelector   MonitorNamespaceSelector `yaml:"namespaceSelector,omitempty"`
+	PodMetricsEndpoints []MonitorEndpoint        `yaml:"podMetricsEndpoints"`
+}
+
+func (p PodMonitorSpec) Validate(ctx context.Context) error {
+	if len(p.PodMetricsEndpoints) == 0 {
+		return errors.New("podMetricsEndpoints missing")
+	}
+	for _, endpoint := range p.PodMetricsEndpoints {
+		if err := endpoint.Validate(ctx); err != nil {
+			return err
+		}
+	}
+	return validation.Validate(
+		ctx,
+		p.Selector,
+	)
+}
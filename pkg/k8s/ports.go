@@ -62,6 +62,29 @@ func (p PortProtocol) Validate(ctx context.Context) error {
 
 type PortTarget string
 
+// IntOrString is encoded as number if it only contains digits, otherwise as string.
+type IntOrString string
+
+func (i IntOrString) String() string {
+	return string(i)
+}
+
+func (i IntOrString) MarshalYAML() (interface{}, error) {
+	if number, err := strconv.Atoi(i.String()); err == nil {
+		return number, nil
+	}
+	return i.String(), nil
+}
+
+func (i *IntOrString) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var value interface{}
+	if err := unmarshal(&value); err != nil {
+		return err
+	}
+	*i = IntOrString(fmt.Sprint(value))
+	return nil
+}
+
 type ServicePort struct {
 	Name       PortName     `yaml:"name,omitempty"`
 	Port       PortNumber   `yaml:"port"`
@@ -0,0 +1,113 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package k8s
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/golang/glog"
+	"github.com/pkg/errors"
+
+	"github.com/bborbe/world/pkg/world"
+)
+
+// KubernetesApplier is implemented by all appliers deploying with the Deployer.
+type KubernetesApplier interface {
+	world.Applier
+	KubernetesContext() Context
+}
+
+// IsKubernetesApplier returns true if the applier deploys to a Kubernetes context.
+func IsKubernetesApplier(applier world.Applier) bool {
+	_, ok := applier.(KubernetesApplier)
+	return ok
+}
+
+// Render switches all Deployers to render mode. Objects are written to
+// <Dir>/<context>/<namespace>/<kind>-<name>.yaml instead of applied, the cluster is never called.
+// The directory of a context is cleared before its first object is written.
+type Render struct {
+	Dir string
+	// IncludeSecrets writes Secrets too, they are skipped otherwise to keep secret values off the disk.
+	IncludeSecrets bool
+
+	mux     sync.Mutex
+	cleared map[Context]bool
+}
+
+type renderKey struct{}
+
+// WithRender returns a context that switches all Deployers using it to render mode.
+func WithRender(ctx context.Context, render *Render) context.Context {
+	return context.WithValue(ctx, renderKey{}, render)
+}
+
+// renderFromContext returns the Render of the context, nil if not in render mode.
+func renderFromContext(ctx context.Context) *Render {
+	render, _ := ctx.Value(renderKey{}).(*Render)
+	return render
+}
+
+// renderClusterDir is used as namespace directory for cluster scoped objects.
+const renderClusterDir = "_cluster"
+
+// RenderPath returns the file the object is written to in render mode.
+func RenderPath(dir string, context Context, ref ObjectReference) string {
+	namespace := ref.Namespace.String()
+	if namespace == "" {
+		namespace = renderClusterDir
+	}
+	return filepath.Join(dir, context.String(), namespace, strings.ToLower(ref.Kind.String())+"-"+ref.Name.String()+".yaml")
+}
+
+func (r *Render) write(context Context, data []byte) error {
+	ref, err := ParseObjectReference(data)
+	if err != nil {
+		return err
+	}
+	if ref.Kind == "Secret" && !r.IncludeSecrets {
+		glog.V(1).Infof("skip render of %s, secrets are not included", ref)
+		return nil
+	}
+	if err := r.clear(context); err != nil {
+		return err
+	}
+	path := RenderPath(r.Dir, context, *ref)
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return errors.Wrapf(err, "create directory for %s failed", path)
+	}
+	glog.V(1).Infof("render %s to %s", ref, path)
+	if err := ioutil.WriteFile(path, data, 0644); err != nil {
+		return errors.Wrapf(err, "write %s failed", path)
+	}
+	return nil
+}
+
+// clear removes objects of the last render, so deleted objects do not remain.
+func (r *Render) clear(context Context) error {
+	r.mux.Lock()
+	defer r.mux.Unlock()
+	if r.cleared[context] {
+		return nil
+	}
+	dir := filepath.Join(r.Dir, context.String())
+	if err := os.RemoveAll(dir); err != nil {
+		return errors.Wrapf(err, "clear %s failed", dir)
+	}
+	if r.cleared == nil {
+		r.cleared = map[Context]bool{}
+	}
+	r.cleared[context] = true
+	return nil
+}
+
+// errRenderNotFound is returned by Get in render mode, all objects are rendered.
+var errRenderNotFound = &StatusError{Code: http.StatusNotFound, Reason: "NotFound", Message: "render mode"}
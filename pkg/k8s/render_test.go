@@ -0,0 +1,88 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package k8s_test
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/bborbe/world/pkg/k8s"
+)
+
+var _ = Describe("Render", func() {
+	var ctx context.Context
+	var dir string
+	var deployer *k8s.Deployer
+	BeforeEach(func() {
+		ctx = context.Background()
+		var err error
+		dir, err = ioutil.TempDir("", "world-render")
+		Expect(err).To(BeNil())
+		ctx = k8s.WithRender(ctx, &k8s.Render{Dir: dir})
+		deployer = &k8s.Deployer{
+			Context: "render-context",
+			Data: k8s.ConfigMap{
+				ApiVersion: "v1",
+				Kind:       "ConfigMap",
+				Metadata: k8s.Metadata{
+					Namespace: "fruits",
+					Name:      "banana",
+				},
+				Data: k8s.ConfigMapData{
+					"color": "yellow",
+				},
+			},
+		}
+	})
+	AfterEach(func() {
+		_ = os.RemoveAll(dir)
+	})
+	It("writes the object instead of applying it", func() {
+		Expect(deployer.Apply(ctx)).To(BeNil())
+		content, err := ioutil.ReadFile(filepath.Join(dir, "render-context", "fruits", "configmap-banana.yaml"))
+		Expect(err).To(BeNil())
+		Expect(string(content)).To(ContainSubstring("color: yellow"))
+		Expect(string(content)).To(ContainSubstring(k8s.ContentHashAnnotation))
+	})
+	It("is never satisfied", func() {
+		satisfied, err := deployer.Satisfied(ctx)
+		Expect(err).To(BeNil())
+		Expect(satisfied).To(BeFalse())
+	})
+	It("removes files of the last render", func() {
+		stale := filepath.Join(dir, "render-context", "fruits", "configmap-apple.yaml")
+		Expect(os.MkdirAll(filepath.Dir(stale), 0755)).To(BeNil())
+		Expect(ioutil.WriteFile(stale, []byte("stale"), 0644)).To(BeNil())
+		Expect(deployer.Apply(ctx)).To(BeNil())
+		_, err := os.Stat(stale)
+		Expect(os.IsNotExist(err)).To(BeTrue())
+	})
+	It("skips secrets", func() {
+		deployer.Data = k8s.Secret{
+			ApiVersion: "v1",
+			Kind:       "Secret",
+			Metadata: k8s.Metadata{
+				Namespace: "fruits",
+				Name:      "banana",
+			},
+		}
+		Expect(deployer.Apply(ctx)).To(BeNil())
+		_, err := os.Stat(filepath.Join(dir, "render-context", "fruits", "secret-banana.yaml"))
+		Expect(os.IsNotExist(err)).To(BeTrue())
+	})
+	It("is implemented by appliers", func() {
+		Expect(k8s.IsKubernetesApplier(&k8s.ConfigMapApplier{})).To(BeTrue())
+		Expect(k8s.IsKubernetesApplier(&k8s.UnstructuredApplier{})).To(BeTrue())
+	})
+	It("writes cluster scoped objects to the cluster directory", func() {
+		path := k8s.RenderPath("out", "prod", k8s.ObjectReference{Kind: "Namespace", Name: "fruits"})
+		Expect(path).To(Equal(filepath.Join("out", "prod", "_cluster", "namespace-fruits.yaml")))
+	})
+})
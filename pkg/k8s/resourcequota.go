@@ -0,0 +1,127 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package k8s
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+
+	"github.com/bborbe/world/pkg/validation"
+	"github.com/bborbe/world/pkg/world"
+)
+
+type ResourceQuotaConfiguration struct {
+	Context       Context
+	Overlays      Overlays
+	ResourceQuota ResourceQuota
+	Requirements  []world.Configuration
+}
+
+func (c *ResourceQuotaConfiguration) Validate(ctx context.Context) error {
+	return validation.Validate(
+		ctx,
+		c.Context,
+		c.ResourceQuota,
+	)
+}
+
+func (c *ResourceQuotaConfiguration) Applier() (world.Applier, error) {
+	return &ResourceQuotaApplier{
+		Context:       c.Context,
+		Overlays:      c.Overlays,
+		ResourceQuota: c.ResourceQuota,
+	}, nil
+}
+
+func (c *ResourceQuotaConfiguration) Children(ctx context.Context) (world.Configurations, error) {
+	return c.Requirements, nil
+}
+
+type ResourceQuotaApplier struct {
+	Context       Context
+	Overlays      Overlays
+	ResourceQuota ResourceQuota
+}
+
+func (s *ResourceQuotaApplier) KubernetesContext() Context {
+	return s.Context
+}
+
+func (s *ResourceQuotaApplier) Satisfied(ctx context.Context) (bool, error) {
+	deployer := &Deployer{
+		Context:  s.Context,
+		Overlays: s.Overlays,
+		Data:     s.ResourceQuota,
+	}
+	return deployer.Satisfied(ctx)
+}
+
+func (s *ResourceQuotaApplier) Apply(ctx context.Context) error {
+	deployer := &Deployer{
+		Context:  s.Context,
+		Overlays: s.Overlays,
+		Data:     s.ResourceQuota,
+	}
+	return deployer.Apply(ctx)
+}
+
+func (s *ResourceQuotaApplier) Validate(ctx context.Context) error {
+	return validation.Validate(
+		ctx,
+		s.Context,
+		s.Overlays,
+		s.ResourceQuota,
+	)
+}
+
+type ResourceQuota struct {
+	ApiVersion ApiVersion        `yaml:"apiVersion"`
+	Kind       Kind              `yaml:"kind"`
+	Metadata   Metadata          `yaml:"metadata"`
+	Spec       ResourceQuotaSpec `yaml:"spec"`
+}
+
+func (s ResourceQuota) String() string {
+	return fmt.Sprintf("%s/%s to %s", s.Kind, s.Metadata.Name, s.Metadata.Namespace)
+}
+
+func (s ResourceQuota) Validate(ctx context.Context) error {
+	if s.ApiVersion != "v1" {
+		return errors.New("invalid ApiVersion")
+	}
+	if s.Kind != "ResourceQuota" {
+		return errors.New("invalid Kind")
+	}
+	return validation.Validate(
+		ctx,
+		s.Metadata,
+		s.Spec,
+	)
+}
+
+// ResourceList maps resource names like cpu, memory or pods to quantities.
+type ResourceList map[string]string
+
+func (r ResourceList) Validate(ctx context.Context) error {
+	for name, quantity := range r {
+		if name == "" || quantity == "" {
+			return errors.Errorf("invalid resource %s=%s", name, quantity)
+		}
+	}
+	return nil
+}
+
+type ResourceQuotaSpec struct {
+	Hard ResourceList `yaml:"hard"`
+}
+
+func (r ResourceQuotaSpec) Validate(ctx context.Context) error {
+	if len(r.Hard) == 0 {
+		return errors.New("hard limits missing")
+	}
+	return r.Hard.Validate(ctx)
+}
@@ -16,6 +16,7 @@ import (
 
 type RoleConfiguration struct {
 	Context      Context
+	Overlays     Overlays
 	Role         Role
 	Requirements []world.Configuration
 }
@@ -30,8 +31,9 @@ func (r *RoleConfiguration) Validate(ctx context.Context) error {
 
 func (r *RoleConfiguration) Applier() (world.Applier, error) {
 	return &RoleApplier{
-		Context: r.Context,
-		Role:    r.Role,
+		Context:  r.Context,
+		Overlays: r.Overlays,
+		Role:     r.Role,
 	}, nil
 }
 
@@ -53,18 +55,29 @@ func (r RoleName) Validate(ctx context.Context) error {
 }
 
 type RoleApplier struct {
-	Context Context
-	Role    Role
+	Context  Context
+	Overlays Overlays
+	Role     Role
+}
+
+func (s *RoleApplier) KubernetesContext() Context {
+	return s.Context
 }
 
 func (s *RoleApplier) Satisfied(ctx context.Context) (bool, error) {
-	return false, nil
+	deployer := &Deployer{
+		Context:  s.Context,
+		Overlays: s.Overlays,
+		Data:     s.Role,
+	}
+	return deployer.Satisfied(ctx)
 }
 
 func (s *RoleApplier) Apply(ctx context.Context) error {
 	deployer := &Deployer{
-		Context: s.Context,
-		Data:    s.Role,
+		Context:  s.Context,
+		Overlays: s.Overlays,
+		Data:     s.Role,
 	}
 	return deployer.Apply(ctx)
 }
@@ -73,6 +86,9 @@ func (s *RoleApplier) Validate(ctx context.Context) error {
 	if s.Context == "" {
 		return errors.New("context missing")
 	}
+	if err := s.Overlays.Validate(ctx); err != nil {
+		return err
+	}
 	return s.Role.Validate(ctx)
 }
 
@@ -16,6 +16,7 @@ import (
 
 type RoleBindingConfiguration struct {
 	Context      Context
+	Overlays     Overlays
 	RoleBinding  RoleBinding
 	Requirements []world.Configuration
 }
@@ -31,6 +32,7 @@ func (r *RoleBindingConfiguration) Validate(ctx context.Context) error {
 func (r *RoleBindingConfiguration) Applier() (world.Applier, error) {
 	return &RoleBindingApplier{
 		Context:     r.Context,
+		Overlays:    r.Overlays,
 		RoleBinding: r.RoleBinding,
 	}, nil
 }
@@ -54,17 +56,28 @@ func (a RoleBindingName) Validate(ctx context.Context) error {
 
 type RoleBindingApplier struct {
 	Context     Context
+	Overlays    Overlays
 	RoleBinding RoleBinding
 }
 
+func (s *RoleBindingApplier) KubernetesContext() Context {
+	return s.Context
+}
+
 func (s *RoleBindingApplier) Satisfied(ctx context.Context) (bool, error) {
-	return false, nil
+	deployer := &Deployer{
+		Context:  s.Context,
+		Overlays: s.Overlays,
+		Data:     s.RoleBinding,
+	}
+	return deployer.Satisfied(ctx)
 }
 
 func (s *RoleBindingApplier) Apply(ctx context.Context) error {
 	deployer := &Deployer{
-		Context: s.Context,
-		Data:    s.RoleBinding,
+		Context:  s.Context,
+		Overlays: s.Overlays,
+		Data:     s.RoleBinding,
 	}
 	return deployer.Apply(ctx)
 }
@@ -73,6 +86,9 @@ func (s *RoleBindingApplier) Validate(ctx context.Context) error {
 	if s.Context == "" {
 		return errors.New("context missing")
 	}
+	if err := s.Overlays.Validate(ctx); err != nil {
+		return err
+	}
 	return s.RoleBinding.Validate(ctx)
 }
 
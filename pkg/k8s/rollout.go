@@ -0,0 +1,441 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package k8s
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"os/exec"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/golang/glog"
+	"github.com/pkg/errors"
+)
+
+const (
+	DefaultRolloutTimeout  = 5 * time.Minute
+	DefaultRolloutInterval = 2 * time.Second
+	rolloutMaxPods         = 3
+	rolloutMaxEvents       = 5
+	rolloutLogLines        = 20
+)
+
+// Rollout waits after apply until the pods of a Deployment, StatefulSet or DaemonSet are updated and available.
+type Rollout struct {
+	// Timeout for the rollout, DefaultRolloutTimeout if empty.
+	Timeout time.Duration
+	// Interval between status checks, DefaultRolloutInterval if empty.
+	Interval time.Duration
+	// Undo rolls back to the previous revision if the rollout does not finish in time. Requires kubectl.
+	Undo bool
+	// Skip disables waiting for the rollout.
+	Skip bool
+}
+
+// Wait until the rollout of the object is finished.
+// On failure the error contains events and logs of the affected pods.
+func (r Rollout) Wait(ctx context.Context, kubeContext Context, ref ObjectReference) error {
+	if r.Skip || renderFromContext(ctx) != nil {
+		return nil
+	}
+	api, err := rolloutAPIForContext(kubeContext)
+	if err != nil {
+		return err
+	}
+	timeout := r.Timeout
+	if timeout == 0 {
+		timeout = DefaultRolloutTimeout
+	}
+	interval := r.Interval
+	if interval == 0 {
+		interval = DefaultRolloutInterval
+	}
+	glog.V(2).Infof("wait for rollout of %s in %s", ref, kubeContext)
+	deadline := time.Now().Add(timeout)
+	var status *rolloutStatus
+	for {
+		content, err := api.Get(ctx, ref)
+		if err != nil {
+			return errors.Wrapf(err, "get %s failed", ref)
+		}
+		status, err = parseRolloutStatus(ref.Kind, content)
+		if err != nil {
+			return err
+		}
+		if status.Done {
+			glog.V(2).Infof("rollout of %s finished", ref)
+			return nil
+		}
+		if status.Failed || time.Now().After(deadline) {
+			break
+		}
+		glog.V(3).Infof("rollout of %s: %s", ref, status.Message)
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(interval):
+		}
+	}
+	buf := &bytes.Buffer{}
+	fmt.Fprintf(buf, "rollout of %s not finished after %v: %s", ref, timeout, status.Message)
+	r.writePods(ctx, api, ref, status.Selector, buf)
+	if r.Undo {
+		if err := api.Undo(ctx, ref); err != nil {
+			fmt.Fprintf(buf, "\nrollout undo failed: %v", err)
+		} else {
+			fmt.Fprintf(buf, "\nrollout undone")
+		}
+	}
+	return errors.New(buf.String())
+}
+
+// writePods adds state, events and log excerpts of the pods not ready.
+func (r Rollout) writePods(ctx context.Context, api rolloutAPI, ref ObjectReference, selector map[string]string, buf *bytes.Buffer) {
+	if len(selector) == 0 {
+		return
+	}
+	content, err := api.List(ctx, "v1", "Pod", ref.Namespace, labelSelector(selector), "")
+	if err != nil {
+		fmt.Fprintf(buf, "\nlist pods failed: %v", err)
+		return
+	}
+	var pods rolloutPodList
+	if err := json.Unmarshal(content, &pods); err != nil {
+		fmt.Fprintf(buf, "\nparse pods failed: %v", err)
+		return
+	}
+	count := 0
+	for _, pod := range pods.Items {
+		if pod.Ready() {
+			continue
+		}
+		if count >= rolloutMaxPods {
+			fmt.Fprintf(buf, "\n...")
+			return
+		}
+		count++
+		fmt.Fprintf(buf, "\npod %s: %s", pod.Metadata.Name, pod.State())
+		r.writeEvents(ctx, api, ref.Namespace, pod.Metadata.Name, buf)
+		for _, container := range pod.Status.ContainerStatuses {
+			if container.Ready {
+				continue
+			}
+			logs, err := api.Logs(ctx, ref.Namespace, pod.Metadata.Name, container.Name, container.RestartCount > 0)
+			if err != nil {
+				fmt.Fprintf(buf, "\n  logs of %s failed: %v", container.Name, err)
+				continue
+			}
+			fmt.Fprintf(buf, "\n  logs of %s:", container.Name)
+			for _, line := range strings.Split(strings.TrimSpace(string(logs)), "\n") {
+				fmt.Fprintf(buf, "\n    %s", line)
+			}
+		}
+	}
+}
+
+func (r Rollout) writeEvents(ctx context.Context, api rolloutAPI, namespace NamespaceName, pod string, buf *bytes.Buffer) {
+	content, err := api.List(ctx, "v1", "Event", namespace, "", "involvedObject.name="+pod)
+	if err != nil {
+		fmt.Fprintf(buf, "\n  list events failed: %v", err)
+		return
+	}
+	var events struct {
+		Items []struct {
+			Type          string `json:"type"`
+			Reason        string `json:"reason"`
+			Message       string `json:"message"`
+			LastTimestamp string `json:"lastTimestamp"`
+		} `json:"items"`
+	}
+	if err := json.Unmarshal(content, &events); err != nil {
+		fmt.Fprintf(buf, "\n  parse events failed: %v", err)
+		return
+	}
+	items := events.Items
+	sort.SliceStable(items, func(i, j int) bool {
+		return items[i].LastTimestamp < items[j].LastTimestamp
+	})
+	if len(items) > rolloutMaxEvents {
+		items = items[len(items)-rolloutMaxEvents:]
+	}
+	for _, event := range items {
+		fmt.Fprintf(buf, "\n  event %s %s: %s", event.Type, event.Reason, event.Message)
+	}
+}
+
+func labelSelector(labels map[string]string) string {
+	var parts []string
+	for key, value := range labels {
+		parts = append(parts, fmt.Sprintf("%s=%s", key, value))
+	}
+	sort.Strings(parts)
+	return strings.Join(parts, ",")
+}
+
+type rolloutStatus struct {
+	Done     bool
+	Failed   bool
+	Message  string
+	Selector map[string]string
+}
+
+type rolloutObject struct {
+	Metadata struct {
+		Generation int64 `json:"generation"`
+	} `json:"metadata"`
+	Spec struct {
+		Replicas *int32 `json:"replicas"`
+		Selector struct {
+			MatchLabels map[string]string `json:"matchLabels"`
+		} `json:"selector"`
+		UpdateStrategy struct {
+			Type string `json:"type"`
+		} `json:"updateStrategy"`
+	} `json:"spec"`
+	Status struct {
+		ObservedGeneration     int64  `json:"observedGeneration"`
+		Replicas               int32  `json:"replicas"`
+		UpdatedReplicas        int32  `json:"updatedReplicas"`
+		ReadyReplicas          int32  `json:"readyReplicas"`
+		AvailableReplicas      int32  `json:"availableReplicas"`
+		CurrentRevision        string `json:"currentRevision"`
+		UpdateRevision         string `json:"updateRevision"`
+		DesiredNumberScheduled int32  `json:"desiredNumberScheduled"`
+		UpdatedNumberScheduled int32  `json:"updatedNumberScheduled"`
+		NumberAvailable        int32  `json:"numberAvailable"`
+		Conditions             []struct {
+			Type    string `json:"type"`
+			Reason  string `json:"reason"`
+			Message string `json:"message"`
+		} `json:"conditions"`
+	} `json:"status"`
+}
+
+// parseRolloutStatus follows the checks of kubectl rollout status.
+func parseRolloutStatus(kind Kind, content []byte) (*rolloutStatus, error) {
+	var object rolloutObject
+	if err := json.Unmarshal(content, &object); err != nil {
+		return nil, errors.Wrapf(err, "parse %s failed", kind)
+	}
+	result := &rolloutStatus{
+		Selector: object.Spec.Selector.MatchLabels,
+	}
+	if object.Metadata.Generation > object.Status.ObservedGeneration {
+		result.Message = "waiting for spec update to be observed"
+		return result, nil
+	}
+	replicas := int32(1)
+	if object.Spec.Replicas != nil {
+		replicas = *object.Spec.Replicas
+	}
+	status := object.Status
+	switch kind {
+	case "Deployment":
+		for _, condition := range status.Conditions {
+			if condition.Type == "Progressing" && condition.Reason == "ProgressDeadlineExceeded" {
+				result.Failed = true
+				result.Message = condition.Message
+				return result, nil
+			}
+		}
+		switch {
+		case status.UpdatedReplicas < replicas:
+			result.Message = fmt.Sprintf("%d of %d replicas updated", status.UpdatedReplicas, replicas)
+		case status.Replicas > status.UpdatedReplicas:
+			result.Message = fmt.Sprintf("%d old replicas pending termination", status.Replicas-status.UpdatedReplicas)
+		case status.AvailableReplicas < status.UpdatedReplicas:
+			result.Message = fmt.Sprintf("%d of %d updated replicas available", status.AvailableReplicas, status.UpdatedReplicas)
+		default:
+			result.Done = true
+		}
+	case "StatefulSet":
+		switch {
+		case object.Spec.UpdateStrategy.Type == "OnDelete":
+			result.Done = true
+		case status.ReadyReplicas < replicas:
+			result.Message = fmt.Sprintf("%d of %d replicas ready", status.ReadyReplicas, replicas)
+		case status.UpdateRevision != status.CurrentRevision:
+			result.Message = fmt.Sprintf("%d of %d replicas updated", status.UpdatedReplicas, replicas)
+		default:
+			result.Done = true
+		}
+	case "DaemonSet":
+		switch {
+		case object.Spec.UpdateStrategy.Type == "OnDelete":
+			result.Done = true
+		case status.UpdatedNumberScheduled < status.DesiredNumberScheduled:
+			result.Message = fmt.Sprintf("%d of %d pods updated", status.UpdatedNumberScheduled, status.DesiredNumberScheduled)
+		case status.NumberAvailable < status.DesiredNumberScheduled:
+			result.Message = fmt.Sprintf("%d of %d updated pods available", status.NumberAvailable, status.DesiredNumberScheduled)
+		default:
+			result.Done = true
+		}
+	default:
+		return nil, errors.Errorf("rollout of kind %s not supported", kind)
+	}
+	return result, nil
+}
+
+type rolloutPodList struct {
+	Items []rolloutPod `json:"items"`
+}
+
+type rolloutPod struct {
+	Metadata struct {
+		Name string `json:"name"`
+	} `json:"metadata"`
+	Status struct {
+		Phase             string `json:"phase"`
+		ContainerStatuses []struct {
+			Name         string `json:"name"`
+			Ready        bool   `json:"ready"`
+			RestartCount int    `json:"restartCount"`
+			State        struct {
+				Waiting *struct {
+					Reason string `json:"reason"`
+				} `json:"waiting"`
+				Terminated *struct {
+					Reason string `json:"reason"`
+				} `json:"terminated"`
+			} `json:"state"`
+		} `json:"containerStatuses"`
+	} `json:"status"`
+}
+
+func (p rolloutPod) Ready() bool {
+	if p.Status.Phase != "Running" {
+		return false
+	}
+	for _, container := range p.Status.ContainerStatuses {
+		if !container.Ready {
+			return false
+		}
+	}
+	return true
+}
+
+func (p rolloutPod) State() string {
+	parts := []string{p.Status.Phase}
+	for _, container := range p.Status.ContainerStatuses {
+		reason := ""
+		if container.State.Waiting != nil {
+			reason = container.State.Waiting.Reason
+		}
+		if container.State.Terminated != nil {
+			reason = container.State.Terminated.Reason
+		}
+		if reason != "" || container.RestartCount > 0 {
+			parts = append(parts, fmt.Sprintf("%s %s restarts=%d", container.Name, reason, container.RestartCount))
+		}
+	}
+	return strings.Join(parts, ", ")
+}
+
+// rolloutAPI reads objects and logs from the cluster.
+type rolloutAPI interface {
+	Get(ctx context.Context, ref ObjectReference) ([]byte, error)
+	List(ctx context.Context, apiVersion ApiVersion, kind Kind, namespace NamespaceName, labelSelector string, fieldSelector string) ([]byte, error)
+	Logs(ctx context.Context, namespace NamespaceName, pod string, container string, previous bool) ([]byte, error)
+	Undo(ctx context.Context, ref ObjectReference) error
+}
+
+func rolloutAPIForContext(kubeContext Context) (rolloutAPI, error) {
+	kubectl := &kubectlRolloutAPI{Context: kubeContext}
+	if DefaultTransport == TransportKubectl {
+		return kubectl, nil
+	}
+	client, err := ClientForContext(kubeContext)
+	if err != nil {
+		if DefaultTransport == TransportAuto && errors.Cause(err) == ErrUnsupportedKubeconfig {
+			return kubectl, nil
+		}
+		return nil, errors.Wrapf(err, "create client for %s failed", kubeContext)
+	}
+	return &nativeRolloutAPI{client: client, kubectl: kubectl}, nil
+}
+
+type nativeRolloutAPI struct {
+	client  *Client
+	kubectl *kubectlRolloutAPI
+}
+
+func (n *nativeRolloutAPI) Get(ctx context.Context, ref ObjectReference) ([]byte, error) {
+	return n.client.Get(ctx, ref)
+}
+
+func (n *nativeRolloutAPI) List(ctx context.Context, apiVersion ApiVersion, kind Kind, namespace NamespaceName, labelSelector string, fieldSelector string) ([]byte, error) {
+	query := url.Values{}
+	if labelSelector != "" {
+		query.Set("labelSelector", labelSelector)
+	}
+	if fieldSelector != "" {
+		query.Set("fieldSelector", fieldSelector)
+	}
+	return n.client.List(ctx, apiVersion, kind, namespace, query)
+}
+
+func (n *nativeRolloutAPI) Logs(ctx context.Context, namespace NamespaceName, pod string, container string, previous bool) ([]byte, error) {
+	return n.client.Logs(ctx, namespace, pod, container, previous, rolloutLogLines)
+}
+
+// Undo uses kubectl, the api has no rollback endpoint.
+func (n *nativeRolloutAPI) Undo(ctx context.Context, ref ObjectReference) error {
+	return n.kubectl.Undo(ctx, ref)
+}
+
+type kubectlRolloutAPI struct {
+	Context Context
+}
+
+func (k *kubectlRolloutAPI) Get(ctx context.Context, ref ObjectReference) ([]byte, error) {
+	return k.run(ctx, ref.Namespace, "get", strings.ToLower(ref.Kind.String()), ref.Name.String(), "-o", "json")
+}
+
+func (k *kubectlRolloutAPI) List(ctx context.Context, apiVersion ApiVersion, kind Kind, namespace NamespaceName, labelSelector string, fieldSelector string) ([]byte, error) {
+	args := []string{"get", strings.ToLower(kind.String()), "-o", "json"}
+	if labelSelector != "" {
+		args = append(args, "--selector", labelSelector)
+	}
+	if fieldSelector != "" {
+		args = append(args, "--field-selector", fieldSelector)
+	}
+	return k.run(ctx, namespace, args...)
+}
+
+func (k *kubectlRolloutAPI) Logs(ctx context.Context, namespace NamespaceName, pod string, container string, previous bool) ([]byte, error) {
+	return k.run(ctx, namespace, "logs", pod, "--container", container, fmt.Sprintf("--previous=%t", previous), fmt.Sprintf("--tail=%d", rolloutLogLines))
+}
+
+// Undo rolls back to the previous revision and removes the content hash,
+// so the next run applies the object again.
+func (k *kubectlRolloutAPI) Undo(ctx context.Context, ref ObjectReference) error {
+	resource := fmt.Sprintf("%s/%s", strings.ToLower(ref.Kind.String()), ref.Name)
+	if _, err := k.run(ctx, ref.Namespace, "rollout", "undo", resource); err != nil {
+		return err
+	}
+	_, err := k.run(ctx, ref.Namespace, "annotate", resource, ContentHashAnnotation+"-")
+	return err
+}
+
+func (k *kubectlRolloutAPI) run(ctx context.Context, namespace NamespaceName, args ...string) ([]byte, error) {
+	args = append([]string{"--context", k.Context.String()}, args...)
+	if namespace != "" {
+		args = append(args, "--namespace", namespace.String())
+	}
+	stdout := &bytes.Buffer{}
+	stderr := &bytes.Buffer{}
+	cmd := exec.CommandContext(ctx, "kubectl", args...)
+	cmd.Stdout = stdout
+	cmd.Stderr = stderr
+	if err := cmd.Run(); err != nil {
+		return nil, errors.Wrapf(err, "kubectl %s failed: %s", strings.Join(args, " "), strings.TrimSpace(stderr.String()))
+	}
+	return stdout.Bytes(), nil
+}
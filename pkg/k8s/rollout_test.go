@@ -0,0 +1,113 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package k8s_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/bborbe/world/pkg/k8s"
+)
+
+var _ = Describe("Rollout", func() {
+	var ctx context.Context
+	var server *httptest.Server
+	var statuses []string
+	var kubeconfigPath string
+	var kubeconfigBefore string
+	var contextName k8s.Context
+	var ref k8s.ObjectReference
+	var rollout k8s.Rollout
+	BeforeEach(func() {
+		ctx = context.Background()
+		statuses = nil
+		server = httptest.NewServer(http.HandlerFunc(func(resp http.ResponseWriter, req *http.Request) {
+			switch req.URL.Path {
+			case "/apis/apps/v1":
+				_, _ = resp.Write([]byte(`{"resources":[{"name":"deployments","kind":"Deployment","namespaced":true}]}`))
+			case "/api/v1":
+				_, _ = resp.Write([]byte(`{"resources":[{"name":"pods","kind":"Pod","namespaced":true},{"name":"events","kind":"Event","namespaced":true}]}`))
+			case "/apis/apps/v1/namespaces/fruits/deployments/banana":
+				status := statuses[0]
+				if len(statuses) > 1 {
+					statuses = statuses[1:]
+				}
+				_, _ = resp.Write([]byte(status))
+			case "/api/v1/namespaces/fruits/pods":
+				Expect(req.URL.Query().Get("labelSelector")).To(Equal("app=banana"))
+				_, _ = resp.Write([]byte(`{"items":[{"metadata":{"name":"banana-1"},"status":{"phase":"Running","containerStatuses":[{"name":"banana","ready":false,"restartCount":3,"state":{"waiting":{"reason":"CrashLoopBackOff"}}}]}}]}`))
+			case "/api/v1/namespaces/fruits/events":
+				Expect(req.URL.Query().Get("fieldSelector")).To(Equal("involvedObject.name=banana-1"))
+				_, _ = resp.Write([]byte(`{"items":[{"type":"Warning","reason":"BackOff","message":"Back-off restarting failed container"}]}`))
+			case "/api/v1/namespaces/fruits/pods/banana-1/log":
+				Expect(req.URL.Query().Get("previous")).To(Equal("true"))
+				_, _ = resp.Write([]byte("starting\npanic: config missing\n"))
+			default:
+				resp.WriteHeader(http.StatusNotFound)
+			}
+		}))
+		counter++
+		contextName = k8s.Context(fmt.Sprintf("rollout-%d", counter))
+		kubeconfigPath = writeKubeconfig(server.URL, contextName.String())
+		kubeconfigBefore = os.Getenv("KUBECONFIG")
+		Expect(os.Setenv("KUBECONFIG", kubeconfigPath)).To(BeNil())
+		ref = k8s.ObjectReference{
+			ApiVersion: "apps/v1",
+			Kind:       "Deployment",
+			Namespace:  "fruits",
+			Name:       "banana",
+		}
+		rollout = k8s.Rollout{
+			Timeout:  50 * time.Millisecond,
+			Interval: time.Millisecond,
+		}
+	})
+	AfterEach(func() {
+		server.Close()
+		_ = os.Setenv("KUBECONFIG", kubeconfigBefore)
+		_ = os.RemoveAll(filepath.Dir(kubeconfigPath))
+	})
+	It("waits until all replicas are updated and available", func() {
+		statuses = []string{
+			`{"metadata":{"generation":2},"spec":{"replicas":2},"status":{"observedGeneration":1}}`,
+			`{"metadata":{"generation":2},"spec":{"replicas":2},"status":{"observedGeneration":2,"replicas":3,"updatedReplicas":2,"availableReplicas":1}}`,
+			`{"metadata":{"generation":2},"spec":{"replicas":2},"status":{"observedGeneration":2,"replicas":2,"updatedReplicas":2,"availableReplicas":2}}`,
+		}
+		Expect(rollout.Wait(ctx, contextName, ref)).To(BeNil())
+		Expect(statuses).To(HaveLen(1))
+	})
+	It("returns events and logs if rollout times out", func() {
+		statuses = []string{
+			`{"metadata":{"generation":2},"spec":{"replicas":1,"selector":{"matchLabels":{"app":"banana"}}},"status":{"observedGeneration":2,"replicas":1,"updatedReplicas":1,"availableReplicas":0}}`,
+		}
+		err := rollout.Wait(ctx, contextName, ref)
+		Expect(err).NotTo(BeNil())
+		Expect(err.Error()).To(ContainSubstring("0 of 1 updated replicas available"))
+		Expect(err.Error()).To(ContainSubstring("pod banana-1: Running, banana CrashLoopBackOff restarts=3"))
+		Expect(err.Error()).To(ContainSubstring("event Warning BackOff: Back-off restarting failed container"))
+		Expect(err.Error()).To(ContainSubstring("panic: config missing"))
+	})
+	It("fails immediately if progress deadline exceeded", func() {
+		rollout.Timeout = time.Hour
+		statuses = []string{
+			`{"metadata":{"generation":1},"status":{"observedGeneration":1,"conditions":[{"type":"Progressing","reason":"ProgressDeadlineExceeded","message":"deployment exceeded its progress deadline"}]}}`,
+		}
+		err := rollout.Wait(ctx, contextName, ref)
+		Expect(err).NotTo(BeNil())
+		Expect(err.Error()).To(ContainSubstring("deployment exceeded its progress deadline"))
+	})
+	It("does nothing if skipped", func() {
+		rollout.Skip = true
+		Expect(rollout.Wait(ctx, contextName, ref)).To(BeNil())
+	})
+})
@@ -12,18 +12,29 @@ import (
 )
 
 type SecretApplier struct {
-	Context Context
-	Secret  Secret
+	Context  Context
+	Overlays Overlays
+	Secret   Secret
+}
+
+func (s *SecretApplier) KubernetesContext() Context {
+	return s.Context
 }
 
 func (s *SecretApplier) Satisfied(ctx context.Context) (bool, error) {
-	return false, nil
+	deployer := &Deployer{
+		Context:  s.Context,
+		Overlays: s.Overlays,
+		Data:     s.Secret,
+	}
+	return deployer.Satisfied(ctx)
 }
 
 func (s *SecretApplier) Apply(ctx context.Context) error {
 	deployer := &Deployer{
-		Context: s.Context,
-		Data:    s.Secret,
+		Context:  s.Context,
+		Overlays: s.Overlays,
+		Data:     s.Secret,
 	}
 	return deployer.Apply(ctx)
 }
@@ -32,6 +43,9 @@ func (s *SecretApplier) Validate(ctx context.Context) error {
 	if s.Context == "" {
 		return errors.New("context missing")
 	}
+	if err := s.Overlays.Validate(ctx); err != nil {
+		return err
+	}
 	return s.Secret.Validate(ctx)
 }
 
@@ -16,6 +16,7 @@ import (
 
 type ServiceConfiguration struct {
 	Context      Context
+	Overlays     Overlays
 	Service      Service
 	Requirements []world.Configuration
 }
@@ -30,8 +31,9 @@ func (d *ServiceConfiguration) Validate(ctx context.Context) error {
 
 func (d *ServiceConfiguration) Applier() (world.Applier, error) {
 	return &ServiceApplier{
-		Context: d.Context,
-		Service: d.Service,
+		Context:  d.Context,
+		Overlays: d.Overlays,
+		Service:  d.Service,
 	}, nil
 }
 
@@ -40,18 +42,29 @@ func (d *ServiceConfiguration) Children(ctx context.Context) (world.Configuratio
 }
 
 type ServiceApplier struct {
-	Context Context
-	Service Service
+	Context  Context
+	Overlays Overlays
+	Service  Service
+}
+
+func (s *ServiceApplier) KubernetesContext() Context {
+	return s.Context
 }
 
 func (s *ServiceApplier) Satisfied(ctx context.Context) (bool, error) {
-	return false, nil
+	deployer := &Deployer{
+		Context:  s.Context,
+		Overlays: s.Overlays,
+		Data:     s.Service,
+	}
+	return deployer.Satisfied(ctx)
 }
 
 func (s *ServiceApplier) Apply(ctx context.Context) error {
 	deployer := &Deployer{
-		Context: s.Context,
-		Data:    s.Service,
+		Context:  s.Context,
+		Overlays: s.Overlays,
+		Data:     s.Service,
 	}
 	return deployer.Apply(ctx)
 }
@@ -60,6 +73,9 @@ func (s *ServiceApplier) Validate(ctx context.Context) error {
 	if s.Context == "" {
 		return errors.New("context missing")
 	}
+	if err := s.Overlays.Validate(ctx); err != nil {
+		return err
+	}
 	return s.Service.Validate(ctx)
 }
 
@@ -16,6 +16,7 @@ import (
 
 type ServiceaccountConfiguration struct {
 	Context        Context
+	Overlays       Overlays
 	Serviceaccount ServiceAccount
 	Requirements   []world.Configuration
 }
@@ -31,6 +32,7 @@ func (d *ServiceaccountConfiguration) Validate(ctx context.Context) error {
 func (d *ServiceaccountConfiguration) Applier() (world.Applier, error) {
 	return &ServiceaccountApplier{
 		Context:        d.Context,
+		Overlays:       d.Overlays,
 		Serviceaccount: d.Serviceaccount,
 	}, nil
 }
@@ -41,17 +43,28 @@ func (d *ServiceaccountConfiguration) Children(ctx context.Context) (world.Confi
 
 type ServiceaccountApplier struct {
 	Context        Context
+	Overlays       Overlays
 	Serviceaccount ServiceAccount
 }
 
+func (s *ServiceaccountApplier) KubernetesContext() Context {
+	return s.Context
+}
+
 func (s *ServiceaccountApplier) Satisfied(ctx context.Context) (bool, error) {
-	return false, nil
+	deployer := &Deployer{
+		Context:  s.Context,
+		Overlays: s.Overlays,
+		Data:     s.Serviceaccount,
+	}
+	return deployer.Satisfied(ctx)
 }
 
 func (s *ServiceaccountApplier) Apply(ctx context.Context) error {
 	deployer := &Deployer{
-		Context: s.Context,
-		Data:    s.Serviceaccount,
+		Context:  s.Context,
+		Overlays: s.Overlays,
+		Data:     s.Serviceaccount,
 	}
 	return deployer.Apply(ctx)
 }
@@ -60,6 +73,9 @@ func (s *ServiceaccountApplier) Validate(ctx context.Context) error {
 	if s.Context == "" {
 		return errors.New("context missing")
 	}
+	if err := s.Overlays.Validate(ctx); err != nil {
+		return err
+	}
 	return s.Serviceaccount.Validate(ctx)
 }
 
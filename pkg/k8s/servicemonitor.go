@@ -0,0 +1,148 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package k8s
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+
+	"github.com/bborbe/world/pkg/validation"
+	"github.com/bborbe/world/pkg/world"
+)
+
+type ServiceMonitorConfiguration struct {
+	Context        Context
+	Overlays       Overlays
+	ServiceMonitor ServiceMonitor
+	Requirements   []world.Configuration
+}
+
+func (c *ServiceMonitorConfiguration) Validate(ctx context.Context) error {
+	return validation.Validate(
+		ctx,
+		c.Context,
+		c.ServiceMonitor,
+	)
+}
+
+func (c *ServiceMonitorConfiguration) Applier() (world.Applier, error) {
+	return &ServiceMonitorApplier{
+		Context:        c.Context,
+		Overlays:       c.Overlays,
+		ServiceMonitor: c.ServiceMonitor,
+	}, nil
+}
+
+func (c *ServiceMonitorConfiguration) Children(ctx context.Context) (world.Configurations, error) {
+	return c.Requirements, nil
+}
+
+type ServiceMonitorApplier struct {
+	Context        Context
+	Overlays       Overlays
+	ServiceMonitor ServiceMonitor
+}
+
+func (s *ServiceMonitorApplier) KubernetesContext() Context {
+	return s.Context
+}
+
+func (s *ServiceMonitorApplier) Satisfied(ctx context.Context) (bool, error) {
+	deployer := &Deployer{
+		Context:  s.Context,
+		Overlays: s.Overlays,
+		Data:     s.ServiceMonitor,
+	}
+	return deployer.Satisfied(ctx)
+}
+
+func (s *ServiceMonitorApplier) Apply(ctx context.Context) error {
+	deployer := &Deployer{
+		Context:  s.Context,
+		Overlays: s.Overlays,
+		Data:     s.ServiceMonitor,
+	}
+	return deployer.Apply(ctx)
+}
+
+func (s *ServiceMonitorApplier) Validate(ctx context.Context) error {
+	return validation.Validate(
+		ctx,
+		s.Context,
+		s.Overlays,
+		s.ServiceMonitor,
+	)
+}
+
+type ServiceMonitor struct {
+	ApiVersion ApiVersion         `yaml:"apiVersion"`
+	Kind       Kind               `yaml:"kind"`
+	Metadata   Metadata           `yaml:"metadata"`
+	Spec       ServiceMonitorSpec `yaml:"spec"`
+}
+
+func (s ServiceMonitor) String() string {
+	return fmt.Sprintf("%s/%s to %s", s.Kind, s.Metadata.Name, s.Metadata.Namespace)
+}
+
+func (s ServiceMonitor) Validate(ctx context.Context) error {
+	if s.ApiVersion != "monitoring.coreos.com/v1" {
+		return errors.New("invalid ApiVersion")
+	}
+	if s.Kind != "ServiceMonitor" {
+		return errors.New("invalid Kind")
+	}
+	return validation.Validate(
+		ctx,
+		s.Metadata,
+		s.Spec,
+	)
+}
+
+// ServiceMonitorSpec of the prometheus-operator custom resource.
+type ServiceMonitorSpec struct {
+	JobLabel          string                   `yaml:"jobLabel,omitempty"`
+	Selector          LabelSelector            `yaml:"selector"`
+	NamespaceSelector MonitorNamespaceSelector `yaml:"namespaceSelector,omitempty"`
+	Endpoints         []MonitorEndpoint        `yaml:"endpoints"`
+}
+
+func (s ServiceMonitorSpec) Validate(ctx context.Context) error {
+	if len(s.Endpoints) == 0 {
+		return errors.New("endpoints missing")
+	}
+	for _, endpoint := range s.Endpoints {
+		if err := endpoint.Validate(ctx); err != nil {
+			return err
+		}
+	}
+	return validation.Validate(
+		ctx,
+		s.Selector,
+	)
+}
+
+type MonitorNamespaceSelector struct {
+	Any        bool            `yaml:"any,omitempty"`
+	MatchNames []NamespaceName `yaml:"matchNames,omitempty"`
+}
+
+type MonitorEndpoint struct {
+	Port          PortName `yaml:"port,omitempty"`
+	Path          string   `yaml:"path,omitempty"`
+	Scheme        string   `yaml:"scheme,omitempty"`
+	Interval      string   `yaml:"interval,omitempty"`
+	ScrapeTimeout string   `yaml:"scrapeTimeout,omitempty"`
+	HonorLabels   bool     `yaml:"honorLabels,omitempty"`
+}
+
+func (m MonitorEndpoint) Validate(ctx context.Context) error {
+	if m.Port == "" {
+		return errors.New("endpoint port missing")
+	}
+	return nil
+}
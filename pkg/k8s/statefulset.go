@@ -16,8 +16,10 @@ import (
 
 type StatefulSetConfiguration struct {
 	Context      Context
+	Overlays     Overlays
 	Requirements []world.Configuration
 	StatefulSet  StatefulSet
+	Rollout      Rollout
 }
 
 func (w *StatefulSetConfiguration) Validate(ctx context.Context) error {
@@ -30,7 +32,9 @@ func (w *StatefulSetConfiguration) Validate(ctx context.Context) error {
 func (n *StatefulSetConfiguration) Applier() (world.Applier, error) {
 	return &StatefulSetApplier{
 		Context:     n.Context,
+		Overlays:    n.Overlays,
 		StatefulSet: n.StatefulSet,
+		Rollout:     n.Rollout,
 	}, nil
 }
 
@@ -40,25 +44,45 @@ func (n *StatefulSetConfiguration) Children(ctx context.Context) (world.Configur
 
 type StatefulSetApplier struct {
 	Context     Context
+	Overlays    Overlays
 	StatefulSet StatefulSet
+	Rollout     Rollout
+}
+
+func (s *StatefulSetApplier) KubernetesContext() Context {
+	return s.Context
 }
 
 func (s *StatefulSetApplier) Satisfied(ctx context.Context) (bool, error) {
-	return false, nil
+	deployer := &Deployer{
+		Context:  s.Context,
+		Overlays: s.Overlays,
+		Data:     s.StatefulSet,
+	}
+	return deployer.Satisfied(ctx)
 }
 
 func (s *StatefulSetApplier) Apply(ctx context.Context) error {
 	deployer := &Deployer{
-		Context: s.Context,
-		Data:    s.StatefulSet,
+		Context:  s.Context,
+		Overlays: s.Overlays,
+		Data:     s.StatefulSet,
+	}
+	if err := deployer.Apply(ctx); err != nil {
+		return err
+	}
+	ref, err := deployer.Reference()
+	if err != nil {
+		return err
 	}
-	return deployer.Apply(ctx)
+	return s.Rollout.Wait(ctx, s.Context, *ref)
 }
 
 func (s *StatefulSetApplier) Validate(ctx context.Context) error {
 	return validation.Validate(
 		ctx,
 		s.Context,
+		s.Overlays,
 		s.StatefulSet,
 	)
 }
@@ -71,7 +95,13 @@ type StatefulSet struct {
 }
 
 func (s StatefulSet) Validate(ctx context.Context) error {
-	if s.ApiVersion != "apps/v1beta1" {
+	switch s.ApiVersion {
+	case "apps/v1":
+		if len(s.Spec.Selector.MatchLabels) == 0 {
+			return errors.New("apps/v1 requires selector")
+		}
+	case "apps/v1beta1":
+	default:
 		return errors.New("invalid ApiVersion")
 	}
 	if s.Kind != "StatefulSet" {
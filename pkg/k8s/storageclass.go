@@ -16,6 +16,7 @@ import (
 
 type StorageClassConfiguration struct {
 	Context      Context
+	Overlays     Overlays
 	StorageClass StorageClass
 	Requirements []world.Configuration
 }
@@ -31,6 +32,7 @@ func (d *StorageClassConfiguration) Validate(ctx context.Context) error {
 func (d *StorageClassConfiguration) Applier() (world.Applier, error) {
 	return &StorageClassApplier{
 		Context:      d.Context,
+		Overlays:     d.Overlays,
 		StorageClass: d.StorageClass,
 	}, nil
 }
@@ -41,17 +43,28 @@ func (d *StorageClassConfiguration) Children(ctx context.Context) (world.Configu
 
 type StorageClassApplier struct {
 	Context      Context
+	Overlays     Overlays
 	StorageClass StorageClass
 }
 
+func (s *StorageClassApplier) KubernetesContext() Context {
+	return s.Context
+}
+
 func (s *StorageClassApplier) Satisfied(ctx context.Context) (bool, error) {
-	return false, nil
+	deployer := &Deployer{
+		Context:  s.Context,
+		Overlays: s.Overlays,
+		Data:     s.StorageClass,
+	}
+	return deployer.Satisfied(ctx)
 }
 
 func (s *StorageClassApplier) Apply(ctx context.Context) error {
 	deployer := &Deployer{
-		Context: s.Context,
-		Data:    s.StorageClass,
+		Context:  s.Context,
+		Overlays: s.Overlays,
+		Data:     s.StorageClass,
 	}
 	return deployer.Apply(ctx)
 }
@@ -60,6 +73,9 @@ func (s *StorageClassApplier) Validate(ctx context.Context) error {
 	if s.Context == "" {
 		return errors.New("context missing")
 	}
+	if err := s.Overlays.Validate(ctx); err != nil {
+		return err
+	}
 	return s.StorageClass.Validate(ctx)
 }
 
@@ -0,0 +1,250 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package k8s
+
+import (
+	"bytes"
+	"context"
+	"io"
+
+	"github.com/pkg/errors"
+	"gopkg.in/yaml.v2"
+
+	"github.com/bborbe/world/pkg/content"
+	"github.com/bborbe/world/pkg/validation"
+	"github.com/bborbe/world/pkg/world"
+)
+
+// UnstructuredConfiguration deploys raw yaml or json manifests, for kinds without typed struct like CRDs.
+// Objects removed from the manifests stay in the cluster, see UnstructuredApplier.
+type UnstructuredConfiguration struct {
+	Context      Context
+	Overlays     Overlays
+	Content      content.HasContent
+	Requirements []world.Configuration
+}
+
+func (u *UnstructuredConfiguration) Validate(ctx context.Context) error {
+	return u.applier().Validate(ctx)
+}
+
+func (u *UnstructuredConfiguration) Applier() (world.Applier, error) {
+	return u.applier(), nil
+}
+
+func (u *UnstructuredConfiguration) Children(ctx context.Context) (world.Configurations, error) {
+	return u.Requirements, nil
+}
+
+func (u *UnstructuredConfiguration) applier() *UnstructuredApplier {
+	return &UnstructuredApplier{
+		Context:  u.Context,
+		Overlays: u.Overlays,
+		Content:  u.Content,
+	}
+}
+
+// UnstructuredApplier applies all documents of the content.
+// Objects removed from the content are not pruned. Without a release name there is no record
+// of the objects applied before, so the applier can not tell its own objects from others.
+// Use the HelmApplier for manifests that need prune.
+type UnstructuredApplier struct {
+	Context  Context
+	Overlays Overlays
+	Content  content.HasContent
+}
+
+func (u *UnstructuredApplier) KubernetesContext() Context {
+	return u.Context
+}
+
+func (u *UnstructuredApplier) Satisfied(ctx context.Context) (bool, error) {
+	objects, err := u.Objects(ctx)
+	if err != nil {
+		return false, err
+	}
+	for _, object := range objects {
+		deployer := &Deployer{
+			Context:  u.Context,
+			Overlays: u.Overlays,
+			Data:     object,
+		}
+		satisfied, err := deployer.Satisfied(ctx)
+		if err != nil || !satisfied {
+			return false, err
+		}
+	}
+	return true, nil
+}
+
+func (u *UnstructuredApplier) Apply(ctx context.Context) error {
+	objects, err := u.Objects(ctx)
+	if err != nil {
+		return err
+	}
+	for _, object := range objects {
+		deployer := &Deployer{
+			Context:  u.Context,
+			Overlays: u.Overlays,
+			Data:     object,
+		}
+		if err := deployer.Apply(ctx); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (u *UnstructuredApplier) Validate(ctx context.Context) error {
+	if u.Content == nil {
+		return errors.New("Content missing")
+	}
+	if err := u.Context.Validate(ctx); err != nil {
+		return err
+	}
+	if err := u.Overlays.Validate(ctx); err != nil {
+		return err
+	}
+	objects, err := u.Objects(ctx)
+	if err != nil {
+		return err
+	}
+	for _, object := range objects {
+		if err := object.Validate(ctx); err != nil {
+			return errors.Wrapf(err, "validate %s failed", object)
+		}
+	}
+	return nil
+}
+
+// Objects returns all objects of the content.
+func (u *UnstructuredApplier) Objects(ctx context.Context) ([]Unstructured, error) {
+	data, err := u.Content.Content(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "get content failed")
+	}
+	return ParseUnstructured(data)
+}
+
+// ParseUnstructured reads all documents of the yaml or json. Items of kind List are returned as single objects.
+func ParseUnstructured(data []byte) ([]Unstructured, error) {
+	var result []Unstructured
+	decoder := yaml.NewDecoder(bytes.NewReader(data))
+	for {
+		var document yaml.MapSlice
+		err := decoder.Decode(&document)
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, errors.Wrap(err, "decode document failed")
+		}
+		if len(document) == 0 {
+			continue
+		}
+		objects, err := unstructuredObjects(document)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, objects...)
+	}
+	if len(result) == 0 {
+		return nil, errors.New("no objects found")
+	}
+	return result, nil
+}
+
+func unstructuredObjects(document yaml.MapSlice) ([]Unstructured, error) {
+	object := Unstructured(document)
+	if object.value("kind") != "List" {
+		if _, err := object.Reference(); err != nil {
+			return nil, err
+		}
+		return []Unstructured{object}, nil
+	}
+	var result []Unstructured
+	for _, item := range document {
+		if item.Key != "items" {
+			continue
+		}
+		items, ok := item.Value.([]interface{})
+		if !ok {
+			return nil, errors.New("items of List invalid")
+		}
+		for _, value := range items {
+			itemDocument, ok := value.(yaml.MapSlice)
+			if !ok {
+				return nil, errors.New("item of List invalid")
+			}
+			objects, err := unstructuredObjects(itemDocument)
+			if err != nil {
+				return nil, err
+			}
+			result = append(result, objects...)
+		}
+	}
+	return result, nil
+}
+
+// Unstructured is a Kubernetes object without typed struct. The order of fields is kept.
+type Unstructured yaml.MapSlice
+
+func (u Unstructured) MarshalYAML() (interface{}, error) {
+	return yaml.MapSlice(u), nil
+}
+
+func (u Unstructured) String() string {
+	ref, err := u.Reference()
+	if err != nil {
+		return "invalid object"
+	}
+	return ref.String()
+}
+
+// Reference returns apiVersion, kind, namespace and name of the object.
+func (u Unstructured) Reference() (*ObjectReference, error) {
+	data, err := yaml.Marshal(u)
+	if err != nil {
+		return nil, errors.Wrap(err, "encode object failed")
+	}
+	return ParseObjectReference(data)
+}
+
+func (u Unstructured) Validate(ctx context.Context) error {
+	ref, err := u.Reference()
+	if err != nil {
+		return err
+	}
+	for _, item := range u {
+		if item.Key != "metadata" {
+			continue
+		}
+		if _, ok := item.Value.(yaml.MapSlice); !ok {
+			return errors.New("metadata invalid")
+		}
+	}
+	return validation.Validate(
+		ctx,
+		ref.ApiVersion,
+		ref.Kind,
+		ref.Name,
+	)
+}
+
+// WithNamespace returns a copy of the object with the namespace set.
+func (u Unstructured) WithNamespace(namespace NamespaceName) Unstructured {
+	metadata, _ := u.value("metadata").(yaml.MapSlice)
+	metadata = mapSliceSet(metadata, "namespace", namespace.String())
+	return Unstructured(mapSliceSet(yaml.MapSlice(u), "metadata", metadata))
+}
+
+func (u Unstructured) value(key string) interface{} {
+	for _, item := range u {
+		if item.Key == key {
+			return item.Value
+		}
+	}
+	return nil
+}
@@ -0,0 +1,84 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package k8s_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"gopkg.in/yaml.v2"
+
+	"github.com/bborbe/world/pkg/content"
+	"github.com/bborbe/world/pkg/k8s"
+)
+
+var _ = Describe("Unstructured", func() {
+	var ctx context.Context
+	BeforeEach(func() {
+		ctx = context.Background()
+	})
+	It("parses multiple documents", func() {
+		objects, err := k8s.ParseUnstructured([]byte(`apiVersion: cert-manager.io/v1
+kind: Certificate
+metadata:
+  name: example
+  namespace: web
+spec:
+  secretName: example-tls
+---
+---
+apiVersion: traefik.io/v1alpha1
+kind: IngressRoute
+metadata:
+  name: example
+`))
+		Expect(err).To(BeNil())
+		Expect(objects).To(HaveLen(2))
+		Expect(objects[0].String()).To(Equal("Certificate/example in web"))
+		Expect(objects[1].String()).To(Equal("IngressRoute/example"))
+	})
+	It("keeps order of fields", func() {
+		objects, err := k8s.ParseUnstructured([]byte(`{"kind":"Certificate","apiVersion":"cert-manager.io/v1","metadata":{"name":"example"},"spec":{"secretName":"example-tls"}}`))
+		Expect(err).To(BeNil())
+		data, err := yaml.Marshal(objects[0])
+		Expect(err).To(BeNil())
+		Expect(string(data)).To(Equal("kind: Certificate\napiVersion: cert-manager.io/v1\nmetadata:\n  name: example\nspec:\n  secretName: example-tls\n"))
+	})
+	It("expands lists", func() {
+		objects, err := k8s.ParseUnstructured([]byte(`{"apiVersion":"v1","kind":"List","items":[{"apiVersion":"v1","kind":"ConfigMap","metadata":{"name":"a"}},{"apiVersion":"v1","kind":"ConfigMap","metadata":{"name":"b"}}]}`))
+		Expect(err).To(BeNil())
+		Expect(objects).To(HaveLen(2))
+	})
+	It("rejects object without name", func() {
+		_, err := k8s.ParseUnstructured([]byte("apiVersion: v1\nkind: ConfigMap\nmetadata: {}\n"))
+		Expect(err).NotTo(BeNil())
+	})
+	It("validates templated content", func() {
+		applier := &k8s.UnstructuredApplier{
+			Context: "test",
+			Content: content.Template{
+				Template: "apiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: {{.Name}}\n  namespace: {{.Namespace}}\n",
+				Data: struct {
+					Name      string
+					Namespace string
+				}{
+					Name:      "banana",
+					Namespace: "fruits",
+				},
+			},
+		}
+		Expect(applier.Validate(ctx)).To(BeNil())
+		objects, err := applier.Objects(ctx)
+		Expect(err).To(BeNil())
+		Expect(objects[0].String()).To(Equal("ConfigMap/banana in fruits"))
+	})
+	It("fails validation without content", func() {
+		applier := &k8s.UnstructuredApplier{
+			Context: "test",
+		}
+		Expect(applier.Validate(ctx)).NotTo(BeNil())
+	})
+})
@@ -0,0 +1,72 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package k8s
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// Version is the Kubernetes version of a cluster, like 1.27.
+type Version string
+
+func (v Version) String() string {
+	return string(v)
+}
+
+func (v Version) Validate(ctx context.Context) error {
+	if _, _, err := v.parse(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// AtLeast returns true if the version is equal or newer than the given version.
+func (v Version) AtLeast(other Version) bool {
+	major, minor, err := v.parse()
+	if err != nil {
+		return false
+	}
+	otherMajor, otherMinor, err := other.parse()
+	if err != nil {
+		return false
+	}
+	if major != otherMajor {
+		return major > otherMajor
+	}
+	return minor >= otherMinor
+}
+
+// IngressApiVersion returns the newest Ingress api supported by the version.
+// An empty version returns extensions/v1beta1, so existing Ingresses keep their api until the version is configured.
+func (v Version) IngressApiVersion() ApiVersion {
+	switch {
+	case v.AtLeast("1.19"):
+		return IngressApiVersionV1
+	case v.AtLeast("1.14"):
+		return "networking.k8s.io/v1beta1"
+	default:
+		return "extensions/v1beta1"
+	}
+}
+
+func (v Version) parse() (int, int, error) {
+	parts := strings.Split(strings.TrimPrefix(string(v), "v"), ".")
+	if len(parts) < 2 {
+		return 0, 0, errors.Errorf("invalid version %s", v)
+	}
+	major, err := strconv.Atoi(parts[0])
+	if err != nil {
+		return 0, 0, errors.Errorf("invalid version %s", v)
+	}
+	minor, err := strconv.Atoi(strings.TrimSuffix(parts[1], "+"))
+	if err != nil {
+		return 0, 0, errors.Errorf("invalid version %s", v)
+	}
+	return major, minor, nil
+}
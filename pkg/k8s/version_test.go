@@ -0,0 +1,31 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package k8s_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/bborbe/world/pkg/k8s"
+)
+
+var _ = Describe("Version", func() {
+	It("compares versions", func() {
+		Expect(k8s.Version("1.19").AtLeast("1.19")).To(BeTrue())
+		Expect(k8s.Version("v1.27.3+k3s1").AtLeast("1.19")).To(BeTrue())
+		Expect(k8s.Version("1.9").AtLeast("1.19")).To(BeFalse())
+		Expect(k8s.Version("2.0").AtLeast("1.19")).To(BeTrue())
+	})
+	It("returns ingress api version", func() {
+		Expect(k8s.Version("1.27").IngressApiVersion()).To(Equal(k8s.IngressApiVersionV1))
+		Expect(k8s.Version("1.16").IngressApiVersion()).To(Equal(k8s.ApiVersion("networking.k8s.io/v1beta1")))
+		Expect(k8s.Version("1.13").IngressApiVersion()).To(Equal(k8s.ApiVersion("extensions/v1beta1")))
+	})
+	It("rejects invalid version", func() {
+		Expect(k8s.Version("latest").Validate(context.Background())).NotTo(BeNil())
+	})
+})
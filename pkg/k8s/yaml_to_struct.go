@@ -28,20 +28,31 @@ func YamlToStruct(reader io.Reader, writer io.Writer) error {
 		return errors.New("kind missing in yaml")
 	}
 	mapping := map[string]interface{}{
-		"ClusterRole":         &ClusterRole{},
-		"ClusterRoleBinding":  &ClusterRoleBinding{},
-		"ConfigMap":           &ConfigMap{},
-		"DaemonSet":           &DaemonSet{},
-		"Deployment":          &Deployment{},
-		"Ingress":             &Ingress{},
-		"Namespace":           &Namespace{},
-		"PodDisruptionBudget": &PodDisruptionBudget{},
-		"Role":                &Role{},
-		"RoleBinding":         &RoleBinding{},
-		"Secret":              &Secret{},
-		"Service":             &Service{},
-		"ServiceAccount":      &ServiceAccount{},
-		"StatefulSet":         &StatefulSet{},
+		"ClusterRole":             &ClusterRole{},
+		"ClusterRoleBinding":      &ClusterRoleBinding{},
+		"ConfigMap":               &ConfigMap{},
+		"CronJob":                 &CronJob{},
+		"DaemonSet":               &DaemonSet{},
+		"Deployment":              &Deployment{},
+		"HorizontalPodAutoscaler": &HorizontalPodAutoscaler{},
+		"Ingress":                 &Ingress{},
+		"Job":                     &Job{},
+		"LimitRange":              &LimitRange{},
+		"Namespace":               &Namespace{},
+		"NetworkPolicy":           &NetworkPolicy{},
+		"PersistentVolume":        &PersistentVolume{},
+		"PersistentVolumeClaim":   &PersistentVolumeClaim{},
+		"PodDisruptionBudget":     &PodDisruptionBudget{},
+		"PodMonitor":              &PodMonitor{},
+		"ResourceQuota":           &ResourceQuota{},
+		"Role":                    &Role{},
+		"RoleBinding":             &RoleBinding{},
+		"Secret":                  &Secret{},
+		"Service":                 &Service{},
+		"ServiceAccount":          &ServiceAccount{},
+		"ServiceMonitor":          &ServiceMonitor{},
+		"StatefulSet":             &StatefulSet{},
+		"StorageClass":            &StorageClass{},
 	}
 
 	s, ok := mapping[kind.(string)]
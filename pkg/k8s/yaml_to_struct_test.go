@@ -0,0 +1,54 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package k8s_test
+
+import (
+	"bytes"
+	"strings"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"gopkg.in/yaml.v2"
+
+	"github.com/bborbe/world/pkg/k8s"
+)
+
+var _ = Describe("YamlToStruct", func() {
+	It("converts cronjob", func() {
+		buf := &bytes.Buffer{}
+		err := k8s.YamlToStruct(strings.NewReader(`apiVersion: batch/v1
+kind: CronJob
+metadata:
+  name: backup
+spec:
+  schedule: "0 3 * * *"
+`), buf)
+		Expect(err).To(BeNil())
+		Expect(buf.String()).To(ContainSubstring(`Schedule:"0 3 * * *"`))
+	})
+	It("converts network policy with numeric port", func() {
+		var networkPolicy k8s.NetworkPolicy
+		content := `apiVersion: networking.k8s.io/v1
+kind: NetworkPolicy
+metadata:
+  name: db
+spec:
+  podSelector: {}
+  ingress:
+  - ports:
+    - protocol: TCP
+      port: 5432
+`
+		Expect(yaml.Unmarshal([]byte(content), &networkPolicy)).To(BeNil())
+		Expect(networkPolicy.Spec.Ingress[0].Ports[0].Port).To(Equal(k8s.IntOrString("5432")))
+		out, err := yaml.Marshal(networkPolicy)
+		Expect(err).To(BeNil())
+		Expect(string(out)).To(ContainSubstring("port: 5432\n"))
+	})
+	It("fails on unknown kind", func() {
+		err := k8s.YamlToStruct(strings.NewReader("kind: Banana\n"), &bytes.Buffer{})
+		Expect(err).NotTo(BeNil())
+	})
+})
@@ -18,7 +18,7 @@ import (
 )
 
 type RemoteClient struct {
-	SSH           *ssh.SSH
+	SSH           ssh.Executor
 	ClientName    ClientName
 	ServerName    ServerName
 	ServerAddress ServerAddress
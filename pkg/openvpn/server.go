@@ -24,7 +24,7 @@ import (
 )
 
 type Server struct {
-	SSH         *ssh.SSH
+	SSH         ssh.Executor
 	ServerName  ServerName
 	ServerPort  network.Port
 	ServerIPNet network.IPNet
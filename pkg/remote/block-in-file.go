@@ -0,0 +1,99 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package remote
+
+import (
+	"context"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/bborbe/world/pkg/content"
+	"github.com/bborbe/world/pkg/file"
+	"github.com/bborbe/world/pkg/ssh"
+	"github.com/bborbe/world/pkg/validation"
+)
+
+const defaultBlockMarker = "# {mark} MANAGED BY WORLD"
+
+// BlockInFile manages the lines between a begin and end marker and keeps the rest of the file.
+// The block is appended if the markers are missing. With Absent the block including markers is removed.
+type BlockInFile struct {
+	SSH   ssh.Executor
+	Path  file.HasPath
+	Block content.HasContent
+	// Marker with {mark} replaced by BEGIN and END, default "# {mark} MANAGED BY WORLD".
+	Marker string
+	Absent bool
+}
+
+func (b *BlockInFile) Satisfied(ctx context.Context) (bool, error) {
+	return b.fileEdit().Satisfied(ctx)
+}
+
+func (b *BlockInFile) Apply(ctx context.Context) error {
+	return b.fileEdit().Apply(ctx)
+}
+
+func (b *BlockInFile) Validate(ctx context.Context) error {
+	if b.Path == nil {
+		return errors.New("Path missing")
+	}
+	if !b.Absent && b.Block == nil {
+		return errors.New("Block missing")
+	}
+	if b.Marker != "" && !strings.Contains(b.Marker, "{mark}") {
+		return errors.New("Marker must contain {mark}")
+	}
+	return validation.Validate(
+		ctx,
+		b.SSH,
+	)
+}
+
+func (b *BlockInFile) fileEdit() *fileEdit {
+	return &fileEdit{
+		SSH:  b.SSH,
+		Path: b.Path,
+		Edit: b.edit,
+	}
+}
+
+func (b *BlockInFile) marker(mark string) string {
+	marker := b.Marker
+	if marker == "" {
+		marker = defaultBlockMarker
+	}
+	return strings.Replace(marker, "{mark}", mark, 1)
+}
+
+func (b *BlockInFile) edit(ctx context.Context, lines []string) ([]string, error) {
+	begin, end := -1, -1
+	for i, line := range lines {
+		if line == b.marker("BEGIN") && begin == -1 {
+			begin = i
+		}
+		if line == b.marker("END") && begin != -1 {
+			end = i
+			break
+		}
+	}
+	var block []string
+	if !b.Absent {
+		content, err := b.Block.Content(ctx)
+		if err != nil {
+			return nil, errors.Wrap(err, "get block failed")
+		}
+		block = append(block, b.marker("BEGIN"))
+		block = append(block, splitLines(content)...)
+		block = append(block, b.marker("END"))
+	}
+	if begin == -1 || end == -1 {
+		return append(lines, block...), nil
+	}
+	result := append([]string{}, lines[:begin]...)
+	result = append(result, block...)
+	return append(result, lines[end+1:]...), nil
+}
@@ -17,7 +17,7 @@ import (
 )
 
 type Chmod struct {
-	SSH *ssh.SSH
+	SSH ssh.Executor
 
 	Path file.HasPath
 	Perm file.Perm
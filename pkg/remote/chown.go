@@ -17,7 +17,7 @@ import (
 )
 
 type Chown struct {
-	SSH *ssh.SSH
+	SSH ssh.Executor
 
 	Path  file.HasPath
 	User  file.User
@@ -14,7 +14,7 @@ import (
 )
 
 type Command struct {
-	SSH *ssh.SSH
+	SSH ssh.Executor
 
 	Command string
 }
@@ -16,7 +16,7 @@ import (
 )
 
 type Directory struct {
-	SSH  *ssh.SSH
+	SSH  ssh.Executor
 	Path file.HasPath
 }
 
@@ -0,0 +1,48 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package remote
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+
+	"github.com/bborbe/world/pkg/file"
+	"github.com/bborbe/world/pkg/ssh"
+	"github.com/bborbe/world/pkg/validation"
+)
+
+// FileAbsent removes the file if it exists.
+type FileAbsent struct {
+	SSH  ssh.Executor
+	Path file.HasPath
+}
+
+func (f *FileAbsent) Satisfied(ctx context.Context) (bool, error) {
+	path, err := f.Path.Path(ctx)
+	if err != nil {
+		return false, err
+	}
+	return f.SSH.RunCommand(ctx, fmt.Sprintf("test ! -e %s", path)) == nil, nil
+}
+
+func (f *FileAbsent) Apply(ctx context.Context) error {
+	path, err := f.Path.Path(ctx)
+	if err != nil {
+		return err
+	}
+	return errors.Wrap(f.SSH.RunCommand(ctx, fmt.Sprintf("rm -f %s", path)), "remove file failed")
+}
+
+func (f *FileAbsent) Validate(ctx context.Context) error {
+	if f.Path == nil {
+		return errors.New("Path missing")
+	}
+	return validation.Validate(
+		ctx,
+		f.SSH,
+	)
+}
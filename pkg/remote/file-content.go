@@ -8,6 +8,8 @@ import (
 	"context"
 	"crypto/md5"
 	"fmt"
+	"os"
+	"path/filepath"
 
 	"github.com/pkg/errors"
 
@@ -18,9 +20,13 @@ import (
 )
 
 type FileContent struct {
-	SSH     *ssh.SSH
+	SSH     ssh.Executor
 	Path    file.HasPath
 	Content content.HasContent
+	User    file.User
+	Group   file.Group
+	Perm    file.Perm
+	Backup  bool
 }
 
 func (f *FileContent) Satisfied(ctx context.Context) (bool, error) {
@@ -37,6 +43,11 @@ func (f *FileContent) Satisfied(ctx context.Context) (bool, error) {
 	return f.SSH.RunCommand(ctx, fmt.Sprintf(`echo "%s %s" | md5sum -c`, fmt.Sprintf("%x", h.Sum(nil)), path)) == nil, nil
 }
 
+// tmpPerm of the uploaded temp file, restricted until prepareCommand sets the final owner and mode.
+const tmpPerm os.FileMode = 0600
+
+// Apply uploads the content into a temp file next to the target,
+// sets owner and mode and renames it afterwards, so the target is never written partially.
 func (f *FileContent) Apply(ctx context.Context) error {
 	content, err := f.Content.Content(ctx)
 	if err != nil {
@@ -46,7 +57,31 @@ func (f *FileContent) Apply(ctx context.Context) error {
 	if err != nil {
 		return err
 	}
-	return errors.Wrap(f.SSH.RunCommandStdin(ctx, fmt.Sprintf("cat > %s", path), content), "create file failed")
+	tmpPath := filepath.Join(filepath.Dir(path), fmt.Sprintf(".%s.world-tmp", filepath.Base(path)))
+	if err := f.SSH.CopyFile(ctx, tmpPath, content, tmpPerm); err != nil {
+		return errors.Wrap(err, "copy file failed")
+	}
+	if err := f.SSH.RunCommand(ctx, f.prepareCommand(path, tmpPath)); err != nil {
+		if err := f.SSH.RunCommand(ctx, fmt.Sprintf("rm -f %s", tmpPath)); err != nil {
+			return errors.Wrap(err, "remove temp file failed")
+		}
+		return errors.Wrap(err, "prepare file failed")
+	}
+	if f.Backup {
+		if err := f.SSH.RunCommand(ctx, fmt.Sprintf("if [ -f %s ]; then cp -p %s %s.bak; fi", path, path, path)); err != nil {
+			return errors.Wrap(err, "backup file failed")
+		}
+	}
+	return errors.Wrap(f.SSH.RunCommand(ctx, fmt.Sprintf("mv -f %s %s", tmpPath, path)), "rename file failed")
+}
+
+// prepareCommand applies the configured owner and mode to the temp file.
+// Without configuration the owner and mode of the existing file are kept, new files get mode 0644.
+func (f *FileContent) prepareCommand(path string, tmpPath string) string {
+	if f.User != "" && f.Group != "" && f.Perm != 0 {
+		return fmt.Sprintf("chown %s:%s %s && chmod %s %s", f.User, f.Group, tmpPath, f.Perm, tmpPath)
+	}
+	return fmt.Sprintf("if [ -e %s ]; then chown --reference=%s %s && chmod --reference=%s %s; else chmod 0644 %s; fi", path, path, tmpPath, path, tmpPath, tmpPath)
 }
 
 func (f *FileContent) Validate(ctx context.Context) error {
@@ -0,0 +1,94 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package remote_test
+
+import (
+	"context"
+	"os"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/pkg/errors"
+
+	"github.com/bborbe/world/mocks"
+	"github.com/bborbe/world/pkg/content"
+	"github.com/bborbe/world/pkg/file"
+	"github.com/bborbe/world/pkg/remote"
+)
+
+var _ = Describe("FileContent", func() {
+	var ctx context.Context
+	var err error
+	var executor *mocks.Executor
+	var fileContent *remote.FileContent
+	BeforeEach(func() {
+		ctx = context.Background()
+		executor = &mocks.Executor{}
+		fileContent = &remote.FileContent{
+			SSH:     executor,
+			Path:    file.Path("/etc/banana.conf"),
+			Content: content.Static("hello world"),
+			User:    "root",
+			Group:   "root",
+			Perm:    0644,
+		}
+	})
+	Context("Apply", func() {
+		JustBeforeEach(func() {
+			err = fileContent.Apply(ctx)
+		})
+		It("returns no error", func() {
+			Expect(err).To(BeNil())
+		})
+		It("uploads content into temp file only readable by owner", func() {
+			Expect(executor.CopyFileCallCount()).To(Equal(1))
+			_, path, content, perm := executor.CopyFileArgsForCall(0)
+			Expect(path).To(Equal("/etc/.banana.conf.world-tmp"))
+			Expect(string(content)).To(Equal("hello world"))
+			Expect(perm).To(Equal(os.FileMode(0600)))
+		})
+		It("sets owner and renames temp file", func() {
+			Expect(executor.RunCommandCallCount()).To(Equal(2))
+			_, cmd := executor.RunCommandArgsForCall(0)
+			Expect(cmd).To(Equal("chown root:root /etc/.banana.conf.world-tmp && chmod 0644 /etc/.banana.conf.world-tmp"))
+			_, cmd = executor.RunCommandArgsForCall(1)
+			Expect(cmd).To(Equal("mv -f /etc/.banana.conf.world-tmp /etc/banana.conf"))
+		})
+		Context("with backup", func() {
+			BeforeEach(func() {
+				fileContent.Backup = true
+			})
+			It("backups the existing file before rename", func() {
+				Expect(executor.RunCommandCallCount()).To(Equal(3))
+				_, cmd := executor.RunCommandArgsForCall(1)
+				Expect(cmd).To(Equal("if [ -f /etc/banana.conf ]; then cp -p /etc/banana.conf /etc/banana.conf.bak; fi"))
+			})
+		})
+		Context("without owner and mode", func() {
+			BeforeEach(func() {
+				fileContent.User = ""
+				fileContent.Group = ""
+				fileContent.Perm = 0
+			})
+			It("keeps mode of existing file or uses 0644", func() {
+				_, _, _, perm := executor.CopyFileArgsForCall(0)
+				Expect(perm).To(Equal(os.FileMode(0600)))
+				_, cmd := executor.RunCommandArgsForCall(0)
+				Expect(cmd).To(Equal("if [ -e /etc/banana.conf ]; then chown --reference=/etc/banana.conf /etc/.banana.conf.world-tmp && chmod --reference=/etc/banana.conf /etc/.banana.conf.world-tmp; else chmod 0644 /etc/.banana.conf.world-tmp; fi"))
+			})
+		})
+		Context("upload fails", func() {
+			BeforeEach(func() {
+				executor.CopyFileReturns(errors.New("banana"))
+			})
+			It("returns error", func() {
+				Expect(err).NotTo(BeNil())
+			})
+			It("does not touch the target", func() {
+				Expect(executor.RunCommandCallCount()).To(Equal(0))
+			})
+		})
+	})
+})
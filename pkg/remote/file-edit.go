@@ -0,0 +1,79 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package remote
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/bborbe/world/pkg/content"
+	"github.com/bborbe/world/pkg/file"
+	"github.com/bborbe/world/pkg/ssh"
+)
+
+// fileEdit changes parts of a remote file and keeps owner and mode of the existing file.
+type fileEdit struct {
+	SSH  ssh.Executor
+	Path file.HasPath
+	Edit func(ctx context.Context, lines []string) ([]string, error)
+}
+
+func (f *fileEdit) Satisfied(ctx context.Context) (bool, error) {
+	current, edited, err := f.contents(ctx)
+	if err != nil {
+		return false, err
+	}
+	return bytes.Equal(current, edited), nil
+}
+
+func (f *fileEdit) Apply(ctx context.Context) error {
+	current, edited, err := f.contents(ctx)
+	if err != nil {
+		return err
+	}
+	if bytes.Equal(current, edited) {
+		return nil
+	}
+	fileContent := &FileContent{
+		SSH:     f.SSH,
+		Path:    f.Path,
+		Content: content.Static(edited),
+	}
+	return fileContent.Apply(ctx)
+}
+
+func (f *fileEdit) contents(ctx context.Context) ([]byte, []byte, error) {
+	path, err := f.Path.Path(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	current, err := f.SSH.RunCommandStdout(ctx, fmt.Sprintf("cat %s 2>/dev/null || true", path))
+	if err != nil {
+		return nil, nil, errors.Wrapf(err, "read %s failed", path)
+	}
+	lines, err := f.Edit(ctx, splitLines(current))
+	if err != nil {
+		return nil, nil, errors.Wrapf(err, "edit %s failed", path)
+	}
+	return current, joinLines(lines), nil
+}
+
+func splitLines(content []byte) []string {
+	if len(content) == 0 {
+		return nil
+	}
+	return strings.Split(strings.TrimSuffix(string(content), "\n"), "\n")
+}
+
+func joinLines(lines []string) []byte {
+	if len(lines) == 0 {
+		return nil
+	}
+	return []byte(strings.Join(lines, "\n") + "\n")
+}
@@ -19,7 +19,7 @@ import (
 )
 
 type FileLocalCached struct {
-	SSH       *ssh.SSH
+	SSH       ssh.Executor
 	Path      file.HasPath
 	LocalPath file.HasPath
 	Content   content.HasContent
@@ -17,32 +17,39 @@ import (
 )
 
 type File struct {
-	SSH     *ssh.SSH
+	SSH     ssh.Executor
 	Path    file.HasPath
 	Content content.HasContent
 	User    file.User
 	Group   file.Group
 	Perm    file.Perm
+	Backup  bool
+	// Notify handlers if content, owner or mode changed.
+	Notify Handlers
 }
 
 func (f *File) Children(ctx context.Context) (world.Configurations, error) {
 	return world.Configurations{
-		world.NewConfiguraionBuilder().WithApplier(&FileContent{
+		world.NewConfiguraionBuilder().WithApplier(withNotify(&FileContent{
 			SSH:     f.SSH,
 			Path:    f.Path,
 			Content: f.Content,
-		}),
-		world.NewConfiguraionBuilder().WithApplier(&Chown{
+			User:    f.User,
+			Group:   f.Group,
+			Perm:    f.Perm,
+			Backup:  f.Backup,
+		}, f.Notify)),
+		world.NewConfiguraionBuilder().WithApplier(withNotify(&Chown{
 			SSH:   f.SSH,
 			Path:  f.Path,
 			User:  f.User,
 			Group: f.Group,
-		}),
-		world.NewConfiguraionBuilder().WithApplier(&Chmod{
+		}, f.Notify)),
+		world.NewConfiguraionBuilder().WithApplier(withNotify(&Chmod{
 			SSH:  f.SSH,
 			Path: f.Path,
 			Perm: f.Perm,
-		}),
+		}, f.Notify)),
 	}, nil
 }
 
@@ -60,5 +67,6 @@ func (f *File) Validate(ctx context.Context) error {
 		f.User,
 		f.Group,
 		f.Perm,
+		f.Notify,
 	)
 }
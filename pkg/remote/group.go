@@ -0,0 +1,82 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package remote
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/bborbe/world/pkg/file"
+	"github.com/bborbe/world/pkg/ssh"
+	"github.com/bborbe/world/pkg/validation"
+)
+
+// Group creates the group or updates its gid.
+type Group struct {
+	SSH  ssh.Executor
+	Name file.Group
+	GID  int
+}
+
+func (g *Group) Satisfied(ctx context.Context) (bool, error) {
+	gid, exists, err := g.gid(ctx)
+	if err != nil {
+		return false, err
+	}
+	return exists && (g.GID == 0 || gid == g.GID), nil
+}
+
+func (g *Group) Apply(ctx context.Context) error {
+	_, exists, err := g.gid(ctx)
+	if err != nil {
+		return err
+	}
+	command := "groupadd"
+	if exists {
+		command = "groupmod"
+	}
+	if g.GID != 0 {
+		command = fmt.Sprintf("%s --gid %d", command, g.GID)
+	}
+	return errors.Wrapf(g.SSH.RunCommand(ctx, fmt.Sprintf("%s %s", command, g.Name)), "create or update group %s failed", g.Name)
+}
+
+func (g *Group) Validate(ctx context.Context) error {
+	if g.Name == "" {
+		return errors.New("Name missing")
+	}
+	if g.GID < 0 {
+		return errors.New("GID invalid")
+	}
+	return validation.Validate(
+		ctx,
+		g.SSH,
+		g.Name,
+	)
+}
+
+func (g *Group) gid(ctx context.Context) (int, bool, error) {
+	content, err := g.SSH.RunCommandStdout(ctx, fmt.Sprintf("getent group %s || true", g.Name))
+	if err != nil {
+		return 0, false, errors.Wrap(err, "getent group failed")
+	}
+	line := strings.TrimSpace(string(content))
+	if line == "" {
+		return 0, false, nil
+	}
+	parts := strings.Split(line, ":")
+	if len(parts) != 4 {
+		return 0, false, errors.Errorf("invalid group line '%s'", line)
+	}
+	gid, err := strconv.Atoi(parts[2])
+	if err != nil {
+		return 0, false, errors.Errorf("invalid gid in line '%s'", line)
+	}
+	return gid, true, nil
+}
@@ -0,0 +1,132 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package remote
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+
+	"github.com/bborbe/world/pkg/ssh"
+	"github.com/bborbe/world/pkg/validation"
+	"github.com/bborbe/world/pkg/world"
+)
+
+// Handler is a command executed once per run and host after it was notified.
+type Handler struct {
+	SSH     ssh.Executor
+	Name    string
+	Command string
+}
+
+type handlerKey struct {
+	host interface{}
+	name string
+}
+
+type keyed interface {
+	Key(ctx context.Context) (string, error)
+}
+
+// hostKey identifies the host of the executor, so handlers notified via different executors of the same host run once.
+func hostKey(ctx context.Context, executor ssh.Executor) (interface{}, error) {
+	if k, ok := executor.(keyed); ok {
+		key, err := k.Key(ctx)
+		if err != nil {
+			return nil, errors.Wrap(err, "get key of executor failed")
+		}
+		return key, nil
+	}
+	return executor, nil
+}
+
+func (h handlerKey) String() string {
+	return h.name
+}
+
+// Notify schedules the handler for the end of the run.
+func (h *Handler) Notify(ctx context.Context) error {
+	host, err := hostKey(ctx, h.SSH)
+	if err != nil {
+		return err
+	}
+	return world.Notify(ctx, handlerKey{host: host, name: h.Name}, func(ctx context.Context) error {
+		return errors.Wrapf(h.SSH.RunCommand(ctx, h.Command), "handler %s failed", h.Name)
+	})
+}
+
+func (h *Handler) Validate(ctx context.Context) error {
+	if h.Name == "" {
+		return errors.New("Name missing")
+	}
+	if h.Command == "" {
+		return errors.New("Command missing")
+	}
+	return validation.Validate(
+		ctx,
+		h.SSH,
+	)
+}
+
+func RestartHandler(sshExecutor ssh.Executor, name ServiceName) *Handler {
+	return &Handler{
+		SSH:     sshExecutor,
+		Name:    fmt.Sprintf("restart %s", name),
+		Command: fmt.Sprintf("systemctl restart -- %s", name),
+	}
+}
+
+func ReloadHandler(sshExecutor ssh.Executor, name ServiceName) *Handler {
+	return &Handler{
+		SSH:     sshExecutor,
+		Name:    fmt.Sprintf("reload %s", name),
+		Command: fmt.Sprintf("systemctl reload -- %s", name),
+	}
+}
+
+type Handlers []*Handler
+
+func (h Handlers) Notify(ctx context.Context) error {
+	for _, handler := range h {
+		if err := handler.Notify(ctx); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (h Handlers) Validate(ctx context.Context) error {
+	for _, handler := range h {
+		if err := handler.Validate(ctx); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// notifyApplier notifies the handlers after the applier changed something.
+type notifyApplier struct {
+	world.Applier
+	handlers Handlers
+}
+
+func (n *notifyApplier) Apply(ctx context.Context) error {
+	if err := n.Applier.Apply(ctx); err != nil {
+		return err
+	}
+	return n.handlers.Notify(ctx)
+}
+
+// withNotify wraps the applier if handlers are present.
+func withNotify(applier world.Applier, handlers Handlers) world.Applier {
+	if len(handlers) == 0 {
+		return applier
+	}
+	return &notifyApplier{
+		Applier:  applier,
+		handlers: handlers,
+	}
+}
@@ -0,0 +1,111 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package remote_test
+
+import (
+	"context"
+	"strings"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/bborbe/world/pkg/content"
+	"github.com/bborbe/world/pkg/file"
+	"github.com/bborbe/world/pkg/remote"
+	"github.com/bborbe/world/pkg/ssh/sshtest"
+	"github.com/bborbe/world/pkg/world"
+)
+
+var _ = Describe("Handler", func() {
+	var ctx context.Context
+	var recorder *sshtest.Recorder
+	var apply func() error
+	BeforeEach(func() {
+		ctx = context.Background()
+		recorder = &sshtest.Recorder{}
+		apply = func() error {
+			var configurations world.Configurations
+			for _, name := range []string{"a", "b"} {
+				configurations = append(configurations, &remote.File{
+					SSH:     recorder,
+					Path:    file.Path("/etc/nginx/sites-enabled/" + name + ".conf"),
+					Content: content.Static(name),
+					User:    "root",
+					Group:   "root",
+					Perm:    0644,
+					Notify:  remote.Handlers{remote.RestartHandler(recorder, "nginx")},
+				})
+			}
+			builder := world.Builder{
+				Configuration: world.NewConfiguraionBuilder().WithChildren(configurations),
+			}
+			runner, err := builder.Build(ctx)
+			Expect(err).To(BeNil())
+			return runner.Apply(ctx)
+		}
+	})
+	countRestarts := func() int {
+		counter := 0
+		for _, record := range recorder.Records() {
+			if record.Command == "systemctl restart -- nginx" {
+				counter++
+			}
+		}
+		return counter
+	}
+	It("does not run handler if nothing changed", func() {
+		recorder.Responses = []sshtest.Response{
+			{Pattern: `^stat -c '%U:%G'`, Stdout: []byte("root:root\n")},
+			{Pattern: `^stat -c '%a'`, Stdout: []byte("644\n")},
+		}
+		Expect(apply()).To(BeNil())
+		Expect(countRestarts()).To(Equal(0))
+	})
+	It("runs handler once after all files changed", func() {
+		recorder.Responses = []sshtest.Response{
+			{Pattern: `md5sum -c`, Fail: true},
+			{Pattern: `^stat -c '%U:%G'`, Stdout: []byte("root:root\n")},
+			{Pattern: `^stat -c '%a'`, Stdout: []byte("644\n")},
+		}
+		Expect(apply()).To(BeNil())
+		Expect(countRestarts()).To(Equal(1))
+		records := recorder.Records()
+		Expect(records[len(records)-1].Command).To(Equal("systemctl restart -- nginx"))
+	})
+	It("runs handler if only mode changed", func() {
+		recorder.Responses = []sshtest.Response{
+			{Pattern: `^stat -c '%U:%G'`, Stdout: []byte("root:root\n")},
+			{Pattern: `^stat -c '%a'`, Stdout: []byte("600\n")},
+		}
+		Expect(apply()).To(BeNil())
+		Expect(countRestarts()).To(Equal(1))
+	})
+	It("runs handler once per host for different executors", func() {
+		first := &sshtest.Recorder{Host: "root@server"}
+		second := &sshtest.Recorder{Host: "root@server"}
+		var configurations world.Configurations
+		for _, executor := range []*sshtest.Recorder{first, second} {
+			executor.Responses = []sshtest.Response{
+				{Pattern: `md5sum -c`, Fail: true},
+			}
+			configurations = append(configurations, &remote.File{
+				SSH:     executor,
+				Path:    file.Path("/etc/nginx/nginx.conf"),
+				Content: content.Static("banana"),
+				User:    "root",
+				Group:   "root",
+				Perm:    0644,
+				Notify:  remote.Handlers{remote.RestartHandler(executor, "nginx")},
+			})
+		}
+		builder := world.Builder{
+			Configuration: world.NewConfiguraionBuilder().WithChildren(configurations),
+		}
+		runner, err := builder.Build(ctx)
+		Expect(err).To(BeNil())
+		Expect(runner.Apply(ctx)).To(BeNil())
+		Expect(strings.Count(first.Transcript()+second.Transcript(), "systemctl restart -- nginx")).To(Equal(1))
+	})
+})
@@ -14,7 +14,7 @@ import (
 )
 
 type IptablesAllowForward struct {
-	SSH *ssh.SSH
+	SSH ssh.Executor
 }
 
 func (i *IptablesAllowForward) Satisfied(ctx context.Context) (bool, error) {
@@ -17,7 +17,7 @@ import (
 )
 
 type IptablesAllowInput struct {
-	SSH       *ssh.SSH
+	SSH       ssh.Executor
 	Port      network.Port
 	PortRange *network.PortRange
 	Protocol  network.Protocol
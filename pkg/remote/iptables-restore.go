@@ -0,0 +1,158 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package remote
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/golang/glog"
+	"github.com/pkg/errors"
+
+	"github.com/bborbe/world/pkg/content"
+	"github.com/bborbe/world/pkg/ssh"
+	"github.com/bborbe/world/pkg/validation"
+)
+
+const (
+	iptablesRulesPath    = "/etc/iptables/rules.v4"
+	iptablesNewPath      = "/etc/iptables/rules.v4.world-new"
+	iptablesRollbackPath = "/etc/iptables/rules.v4.world-rollback"
+	iptablesRollbackUnit = "world-firewall-rollback"
+)
+
+var iptablesCounters = regexp.MustCompile(`\[\d+:\d+\]$`)
+
+// IptablesRestore replaces the complete ruleset of all tables in Content with iptables-restore.
+// If the host is not reachable after the change, the previous rules are restored after RollbackTimeout.
+type IptablesRestore struct {
+	SSH     ssh.Executor
+	Content content.HasContent
+	// RollbackTimeout after that the previous rules are restored. Default 60 seconds.
+	RollbackTimeout time.Duration
+}
+
+// Satisfied if the live rules of all tables equal the Content.
+func (i *IptablesRestore) Satisfied(ctx context.Context) (bool, error) {
+	rules, err := i.Content.Content(ctx)
+	if err != nil {
+		return false, errors.Wrap(err, "get content failed")
+	}
+	live := &bytes.Buffer{}
+	for _, table := range IptablesTables(rules) {
+		stdout, err := i.SSH.RunCommandStdout(ctx, fmt.Sprintf("iptables-save -t %s", table))
+		if err != nil {
+			return false, errors.Wrapf(err, "save table %s failed", table)
+		}
+		live.Write(stdout)
+	}
+	return NormalizeIptables(live.Bytes()) == NormalizeIptables(rules), nil
+}
+
+func (i *IptablesRestore) Apply(ctx context.Context) error {
+	rules, err := i.Content.Content(ctx)
+	if err != nil {
+		return errors.Wrap(err, "get content failed")
+	}
+	if err := i.SSH.RunCommand(ctx, "install -d -m 0755 /etc/iptables"); err != nil {
+		return errors.Wrap(err, "create directory failed")
+	}
+	if err := i.SSH.CopyFile(ctx, iptablesNewPath, rules, 0600); err != nil {
+		return errors.Wrap(err, "copy rules failed")
+	}
+	if err := i.SSH.RunCommand(ctx, fmt.Sprintf("iptables-restore --test < %s", iptablesNewPath)); err != nil {
+		return errors.Wrap(err, "rules invalid")
+	}
+	if err := i.SSH.RunCommand(ctx, fmt.Sprintf("iptables-save > %s", iptablesRollbackPath)); err != nil {
+		return errors.Wrap(err, "save current rules failed")
+	}
+	if err := i.SSH.RunCommand(ctx, fmt.Sprintf(
+		`systemctl stop %s.timer 2>/dev/null; systemctl reset-failed %s.service 2>/dev/null; systemd-run --unit=%s --on-active=%d /bin/sh -c "iptables-restore < %s"`,
+		iptablesRollbackUnit,
+		iptablesRollbackUnit,
+		iptablesRollbackUnit,
+		int(i.rollbackTimeout().Seconds()),
+		iptablesRollbackPath,
+	)); err != nil {
+		return errors.Wrap(err, "schedule rollback failed")
+	}
+	if err := i.SSH.RunCommand(ctx, fmt.Sprintf("iptables-restore < %s", iptablesNewPath)); err != nil {
+		return errors.Wrap(err, "restore rules failed")
+	}
+
+	// reconnect to verify the new rules still allow ssh
+	i.SSH.Close()
+	if err := i.SSH.RunCommand(ctx, fmt.Sprintf("systemctl stop %s.timer", iptablesRollbackUnit)); err != nil {
+		return errors.Wrapf(err, "host not reachable with new rules, rollback in %v", i.rollbackTimeout())
+	}
+	glog.V(2).Infof("new firewall rules confirmed")
+	return errors.Wrap(i.SSH.RunCommand(ctx, fmt.Sprintf("mv -f %s %s", iptablesNewPath, iptablesRulesPath)), "persist rules failed")
+}
+
+func (i *IptablesRestore) Validate(ctx context.Context) error {
+	if i.Content == nil {
+		return errors.New("Content missing")
+	}
+	return validation.Validate(
+		ctx,
+		i.SSH,
+	)
+}
+
+func (i *IptablesRestore) rollbackTimeout() time.Duration {
+	if i.RollbackTimeout <= 0 {
+		return time.Minute
+	}
+	return i.RollbackTimeout
+}
+
+// IptablesTables returns the names of all tables in the iptables-restore content.
+func IptablesTables(content []byte) []string {
+	var result []string
+	scanner := bufio.NewScanner(bytes.NewReader(content))
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if strings.HasPrefix(line, "*") {
+			result = append(result, strings.TrimPrefix(line, "*"))
+		}
+	}
+	return result
+}
+
+// NormalizeIptables removes comments and counters and sorts the chain declarations,
+// so the output of iptables-save can be compared with the desired rules.
+func NormalizeIptables(content []byte) string {
+	result := &bytes.Buffer{}
+	var chains []string
+	var rules []string
+	scanner := bufio.NewScanner(bytes.NewReader(content))
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		switch {
+		case line == "" || strings.HasPrefix(line, "#"):
+		case strings.HasPrefix(line, "*"):
+			fmt.Fprintln(result, line)
+			chains = nil
+			rules = nil
+		case strings.HasPrefix(line, ":"):
+			chains = append(chains, iptablesCounters.ReplaceAllString(line, "[0:0]"))
+		case line == "COMMIT":
+			sort.Strings(chains)
+			for _, l := range append(chains, rules...) {
+				fmt.Fprintln(result, l)
+			}
+			fmt.Fprintln(result, line)
+		default:
+			rules = append(rules, line)
+		}
+	}
+	return result.String()
+}
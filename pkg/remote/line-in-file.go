@@ -0,0 +1,97 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package remote
+
+import (
+	"context"
+	"regexp"
+
+	"github.com/pkg/errors"
+
+	"github.com/bborbe/world/pkg/file"
+	"github.com/bborbe/world/pkg/ssh"
+	"github.com/bborbe/world/pkg/validation"
+)
+
+// LineInFile ensures the line is present in the file.
+// The last line matching Regexp is replaced, otherwise the line is appended.
+// With Absent all lines matching Regexp, or equal to Line without Regexp, are removed.
+type LineInFile struct {
+	SSH    ssh.Executor
+	Path   file.HasPath
+	Regexp string
+	Line   string
+	Absent bool
+}
+
+func (l *LineInFile) Satisfied(ctx context.Context) (bool, error) {
+	return l.fileEdit().Satisfied(ctx)
+}
+
+func (l *LineInFile) Apply(ctx context.Context) error {
+	return l.fileEdit().Apply(ctx)
+}
+
+func (l *LineInFile) Validate(ctx context.Context) error {
+	if l.Path == nil {
+		return errors.New("Path missing")
+	}
+	if _, err := regexp.Compile(l.Regexp); err != nil {
+		return errors.Wrapf(err, "invalid regexp %s", l.Regexp)
+	}
+	if l.Absent && l.Regexp == "" && l.Line == "" {
+		return errors.New("Regexp or Line required")
+	}
+	if !l.Absent && l.Line == "" {
+		return errors.New("Line missing")
+	}
+	return validation.Validate(
+		ctx,
+		l.SSH,
+	)
+}
+
+func (l *LineInFile) fileEdit() *fileEdit {
+	return &fileEdit{
+		SSH:  l.SSH,
+		Path: l.Path,
+		Edit: l.edit,
+	}
+}
+
+func (l *LineInFile) edit(ctx context.Context, lines []string) ([]string, error) {
+	matches := func(line string) bool {
+		return line == l.Line
+	}
+	if l.Regexp != "" {
+		re, err := regexp.Compile(l.Regexp)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid regexp %s", l.Regexp)
+		}
+		matches = re.MatchString
+	}
+	if l.Absent {
+		var result []string
+		for _, line := range lines {
+			if !matches(line) {
+				result = append(result, line)
+			}
+		}
+		return result, nil
+	}
+	for i := len(lines) - 1; i >= 0; i-- {
+		if matches(lines[i]) {
+			result := append([]string{}, lines...)
+			result[i] = l.Line
+			return result, nil
+		}
+	}
+	for _, line := range lines {
+		if line == l.Line {
+			return lines, nil
+		}
+	}
+	return append(lines, l.Line), nil
+}
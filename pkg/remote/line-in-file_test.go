@@ -0,0 +1,166 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package remote_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/bborbe/world/pkg/content"
+	"github.com/bborbe/world/pkg/file"
+	"github.com/bborbe/world/pkg/remote"
+	"github.com/bborbe/world/pkg/ssh/sshtest"
+)
+
+// uploaded returns the content written by the applier.
+func uploaded(recorder *sshtest.Recorder) string {
+	for _, record := range recorder.Records() {
+		if record.Method == "copy" {
+			return string(record.Content)
+		}
+	}
+	return ""
+}
+
+func remoteFile(data string) *sshtest.Recorder {
+	return &sshtest.Recorder{
+		Responses: []sshtest.Response{{Pattern: `^cat `, Stdout: []byte(data)}},
+	}
+}
+
+var _ = Describe("LineInFile", func() {
+	var ctx context.Context
+	var recorder *sshtest.Recorder
+	var lineInFile *remote.LineInFile
+	BeforeEach(func() {
+		ctx = context.Background()
+		lineInFile = &remote.LineInFile{
+			Path:   file.Path("/etc/ssh/sshd_config"),
+			Regexp: `^#?PasswordAuthentication `,
+			Line:   "PasswordAuthentication no",
+		}
+	})
+	JustBeforeEach(func() {
+		lineInFile.SSH = recorder
+	})
+	Context("line present", func() {
+		BeforeEach(func() {
+			recorder = remoteFile("Port 22\nPasswordAuthentication no\n")
+		})
+		It("is satisfied", func() {
+			Expect(lineInFile.Satisfied(ctx)).To(BeTrue())
+		})
+		It("writes nothing", func() {
+			Expect(lineInFile.Apply(ctx)).To(BeNil())
+			Expect(uploaded(recorder)).To(Equal(""))
+		})
+	})
+	Context("line matches regexp", func() {
+		BeforeEach(func() {
+			recorder = remoteFile("Port 22\n#PasswordAuthentication yes\nUsePAM yes\n")
+		})
+		It("is not satisfied", func() {
+			Expect(lineInFile.Satisfied(ctx)).To(BeFalse())
+		})
+		It("replaces line", func() {
+			Expect(lineInFile.Apply(ctx)).To(BeNil())
+			Expect(uploaded(recorder)).To(Equal("Port 22\nPasswordAuthentication no\nUsePAM yes\n"))
+		})
+	})
+	Context("line missing", func() {
+		BeforeEach(func() {
+			recorder = remoteFile("Port 22")
+		})
+		It("appends line", func() {
+			Expect(lineInFile.Apply(ctx)).To(BeNil())
+			Expect(uploaded(recorder)).To(Equal("Port 22\nPasswordAuthentication no\n"))
+		})
+	})
+	Context("absent", func() {
+		BeforeEach(func() {
+			recorder = remoteFile("Port 22\nPasswordAuthentication yes\n")
+			lineInFile.Absent = true
+		})
+		It("removes matching lines", func() {
+			Expect(lineInFile.Apply(ctx)).To(BeNil())
+			Expect(uploaded(recorder)).To(Equal("Port 22\n"))
+		})
+	})
+})
+
+var _ = Describe("BlockInFile", func() {
+	var ctx context.Context
+	var recorder *sshtest.Recorder
+	var blockInFile *remote.BlockInFile
+	BeforeEach(func() {
+		ctx = context.Background()
+		blockInFile = &remote.BlockInFile{
+			Path:  file.Path("/etc/hosts"),
+			Block: content.Static("10.0.0.1 nuke\n10.0.0.2 fire\n"),
+		}
+	})
+	JustBeforeEach(func() {
+		blockInFile.SSH = recorder
+	})
+	Context("block missing", func() {
+		BeforeEach(func() {
+			recorder = remoteFile("127.0.0.1 localhost\n")
+		})
+		It("appends block", func() {
+			Expect(blockInFile.Apply(ctx)).To(BeNil())
+			Expect(uploaded(recorder)).To(Equal("127.0.0.1 localhost\n# BEGIN MANAGED BY WORLD\n10.0.0.1 nuke\n10.0.0.2 fire\n# END MANAGED BY WORLD\n"))
+		})
+	})
+	Context("block outdated", func() {
+		BeforeEach(func() {
+			recorder = remoteFile("127.0.0.1 localhost\n# BEGIN MANAGED BY WORLD\n10.0.0.9 old\n# END MANAGED BY WORLD\n::1 localhost\n")
+		})
+		It("replaces block and keeps the rest", func() {
+			Expect(blockInFile.Apply(ctx)).To(BeNil())
+			Expect(uploaded(recorder)).To(Equal("127.0.0.1 localhost\n# BEGIN MANAGED BY WORLD\n10.0.0.1 nuke\n10.0.0.2 fire\n# END MANAGED BY WORLD\n::1 localhost\n"))
+		})
+		It("removes block if absent", func() {
+			blockInFile.Absent = true
+			Expect(blockInFile.Apply(ctx)).To(BeNil())
+			Expect(uploaded(recorder)).To(Equal("127.0.0.1 localhost\n::1 localhost\n"))
+		})
+	})
+	Context("block up to date", func() {
+		BeforeEach(func() {
+			recorder = remoteFile("# BEGIN MANAGED BY WORLD\n10.0.0.1 nuke\n10.0.0.2 fire\n# END MANAGED BY WORLD\n")
+		})
+		It("is satisfied", func() {
+			Expect(blockInFile.Satisfied(ctx)).To(BeTrue())
+		})
+	})
+})
+
+var _ = Describe("Symlink", func() {
+	var ctx context.Context
+	var symlink *remote.Symlink
+	BeforeEach(func() {
+		ctx = context.Background()
+		symlink = &remote.Symlink{
+			Path:   file.Path("/etc/nginx/sites-enabled/default"),
+			Target: "/etc/nginx/sites-available/default",
+		}
+	})
+	It("is satisfied if link points to target", func() {
+		symlink.SSH = &sshtest.Recorder{
+			Responses: []sshtest.Response{{Pattern: "^readlink", Stdout: []byte("/etc/nginx/sites-available/default\n")}},
+		}
+		Expect(symlink.Satisfied(ctx)).To(BeTrue())
+	})
+	It("creates link", func() {
+		recorder := &sshtest.Recorder{}
+		symlink.SSH = recorder
+		Expect(symlink.Satisfied(ctx)).To(BeFalse())
+		Expect(symlink.Apply(ctx)).To(BeNil())
+		records := recorder.Records()
+		Expect(records[len(records)-1].Command).To(Equal("ln -sfn /etc/nginx/sites-available/default /etc/nginx/sites-enabled/default"))
+	})
+})
@@ -0,0 +1,87 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package remote
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/golang/glog"
+	"github.com/pkg/errors"
+
+	"github.com/bborbe/world/pkg/ssh"
+	"github.com/bborbe/world/pkg/validation"
+)
+
+const bootIDCommand = "cat /proc/sys/kernel/random/boot_id"
+
+// Reboot restarts the host if /var/run/reboot-required exists and waits until it is back.
+type Reboot struct {
+	SSH ssh.Executor
+	// Timeout to wait for the host to come back. Default ten minutes.
+	Timeout time.Duration
+	// PollInterval between reconnect attempts. Default five seconds.
+	PollInterval time.Duration
+}
+
+func (r *Reboot) Satisfied(ctx context.Context) (bool, error) {
+	return r.SSH.RunCommand(ctx, "test -f /var/run/reboot-required") != nil, nil
+}
+
+func (r *Reboot) Apply(ctx context.Context) error {
+	bootID, err := r.SSH.RunCommandStdout(ctx, bootIDCommand)
+	if err != nil {
+		return errors.Wrap(err, "get boot id failed")
+	}
+	glog.V(1).Infof("reboot host")
+	if err := r.SSH.RunCommand(ctx, "systemd-run --on-active=3 /bin/systemctl reboot"); err != nil {
+		return errors.Wrap(err, "schedule reboot failed")
+	}
+	r.SSH.Close()
+
+	ctx, cancel := context.WithTimeout(ctx, r.timeout())
+	defer cancel()
+	ticker := time.NewTicker(r.pollInterval())
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return errors.Wrap(ctx.Err(), "wait for reboot failed")
+		case <-ticker.C:
+			currentBootID, err := r.SSH.RunCommandStdout(ctx, bootIDCommand)
+			if err != nil {
+				glog.V(2).Infof("host not reachable yet: %v", err)
+				r.SSH.Close()
+				continue
+			}
+			if strings.TrimSpace(string(currentBootID)) != strings.TrimSpace(string(bootID)) {
+				glog.V(1).Infof("host is back after reboot")
+				return nil
+			}
+		}
+	}
+}
+
+func (r *Reboot) Validate(ctx context.Context) error {
+	return validation.Validate(
+		ctx,
+		r.SSH,
+	)
+}
+
+func (r *Reboot) timeout() time.Duration {
+	if r.Timeout <= 0 {
+		return 10 * time.Minute
+	}
+	return r.Timeout
+}
+
+func (r *Reboot) pollInterval() time.Duration {
+	if r.PollInterval <= 0 {
+		return 5 * time.Second
+	}
+	return r.PollInterval
+}
@@ -14,7 +14,7 @@ import (
 )
 
 type ServiceStop struct {
-	SSH  *ssh.SSH
+	SSH  ssh.Executor
 	Name ServiceName
 }
 
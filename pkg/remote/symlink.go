@@ -0,0 +1,57 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package remote
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/bborbe/world/pkg/file"
+	"github.com/bborbe/world/pkg/ssh"
+	"github.com/bborbe/world/pkg/validation"
+)
+
+// Symlink ensures Path is a symbolic link pointing to Target.
+type Symlink struct {
+	SSH    ssh.Executor
+	Path   file.HasPath
+	Target string
+}
+
+func (s *Symlink) Satisfied(ctx context.Context) (bool, error) {
+	path, err := s.Path.Path(ctx)
+	if err != nil {
+		return false, err
+	}
+	stdout, err := s.SSH.RunCommandStdout(ctx, fmt.Sprintf("readlink %s || true", path))
+	if err != nil {
+		return false, errors.Wrap(err, "readlink failed")
+	}
+	return strings.TrimSpace(string(stdout)) == s.Target, nil
+}
+
+func (s *Symlink) Apply(ctx context.Context) error {
+	path, err := s.Path.Path(ctx)
+	if err != nil {
+		return err
+	}
+	return errors.Wrap(s.SSH.RunCommand(ctx, fmt.Sprintf("ln -sfn %s %s", s.Target, path)), "create symlink failed")
+}
+
+func (s *Symlink) Validate(ctx context.Context) error {
+	if s.Path == nil {
+		return errors.New("Path missing")
+	}
+	if s.Target == "" {
+		return errors.New("Target missing")
+	}
+	return validation.Validate(
+		ctx,
+		s.SSH,
+	)
+}
@@ -12,7 +12,7 @@ import (
 )
 
 type SystemCtl struct {
-	SSH  *ssh.SSH
+	SSH  ssh.Executor
 	Name ServiceName
 }
 
@@ -49,3 +49,23 @@ func (s *SystemCtl) ServiceDisabled(ctx context.Context) (bool, error) {
 	enabled, err := s.ServiceEnabled(ctx)
 	return !enabled, err
 }
+
+func (s *SystemCtl) ServiceActive(ctx context.Context) (bool, error) {
+	return s.SSH.RunCommand(ctx, fmt.Sprintf("systemctl is-active --quiet -- %s", s.Name)) == nil, nil
+}
+
+func (s *SystemCtl) ServiceFailed(ctx context.Context) (bool, error) {
+	return s.SSH.RunCommand(ctx, fmt.Sprintf("systemctl is-failed --quiet -- %s", s.Name)) == nil, nil
+}
+
+func (s *SystemCtl) RestartService(ctx context.Context) error {
+	return s.SSH.RunCommand(ctx, fmt.Sprintf("systemctl restart -- %s", s.Name))
+}
+
+func (s *SystemCtl) ReloadService(ctx context.Context) error {
+	return s.SSH.RunCommand(ctx, fmt.Sprintf("systemctl reload -- %s", s.Name))
+}
+
+func (s *SystemCtl) DaemonReload(ctx context.Context) error {
+	return s.SSH.RunCommand(ctx, "systemctl daemon-reload")
+}
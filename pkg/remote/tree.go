@@ -0,0 +1,245 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package remote
+
+import (
+	"bytes"
+	"context"
+	"crypto/md5"
+	"fmt"
+	"io/fs"
+	"path"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/bborbe/world/pkg/content"
+	"github.com/bborbe/world/pkg/file"
+	"github.com/bborbe/world/pkg/ssh"
+	"github.com/bborbe/world/pkg/validation"
+)
+
+// TreeRule sets the mode of all files matching the pattern (path.Match on the relative path).
+type TreeRule struct {
+	Pattern string
+	Perm    file.Perm
+}
+
+// Tree syncs all files of the source, like os.DirFS or an embed.FS, to the remote directory.
+// Only changed files are uploaded. With Delete files missing in the source are removed.
+type Tree struct {
+	SSH    ssh.Executor
+	Source fs.FS
+	Path   file.HasPath
+	User   file.User
+	Group  file.Group
+	// Perm of files without matching rule, default 0644.
+	Perm   file.Perm
+	Rules  []TreeRule
+	Delete bool
+}
+
+type treeFile struct {
+	checksum string
+	owner    string
+	perm     string
+}
+
+func (t *Tree) Satisfied(ctx context.Context) (bool, error) {
+	changed, extras, err := t.diff(ctx)
+	if err != nil {
+		return false, err
+	}
+	return len(changed) == 0 && (!t.Delete || len(extras) == 0), nil
+}
+
+func (t *Tree) Apply(ctx context.Context) error {
+	root, err := t.Path.Path(ctx)
+	if err != nil {
+		return err
+	}
+	changed, extras, err := t.diff(ctx)
+	if err != nil {
+		return err
+	}
+	if len(changed) > 0 {
+		if err := t.SSH.RunCommand(ctx, t.mkdirCommand(root, changed)); err != nil {
+			return errors.Wrap(err, "create directories failed")
+		}
+	}
+	for _, name := range changed {
+		data, err := fs.ReadFile(t.Source, name)
+		if err != nil {
+			return errors.Wrapf(err, "read %s failed", name)
+		}
+		fileContent := &FileContent{
+			SSH:     t.SSH,
+			Path:    file.Path(path.Join(root, name)),
+			Content: content.Static(data),
+			User:    t.User,
+			Group:   t.Group,
+			Perm:    t.perm(name),
+		}
+		if err := fileContent.Apply(ctx); err != nil {
+			return errors.Wrapf(err, "upload %s failed", name)
+		}
+	}
+	if t.Delete && len(extras) > 0 {
+		buf := &bytes.Buffer{}
+		fmt.Fprintf(buf, "cd %s && rm -f", root)
+		for _, name := range extras {
+			fmt.Fprintf(buf, " %q", name)
+		}
+		fmt.Fprint(buf, " && find . -mindepth 1 -type d -empty -delete")
+		if err := t.SSH.RunCommand(ctx, buf.String()); err != nil {
+			return errors.Wrap(err, "delete extra files failed")
+		}
+	}
+	return nil
+}
+
+func (t *Tree) Validate(ctx context.Context) error {
+	if t.Source == nil {
+		return errors.New("Source missing")
+	}
+	if t.Path == nil {
+		return errors.New("Path missing")
+	}
+	for _, rule := range t.Rules {
+		if _, err := path.Match(rule.Pattern, ""); err != nil {
+			return errors.Wrapf(err, "invalid pattern %s", rule.Pattern)
+		}
+	}
+	return validation.Validate(
+		ctx,
+		t.SSH,
+		t.User,
+		t.Group,
+	)
+}
+
+func (t *Tree) perm(name string) file.Perm {
+	for _, rule := range t.Rules {
+		if ok, _ := path.Match(rule.Pattern, name); ok {
+			return rule.Perm
+		}
+	}
+	if t.Perm == 0 {
+		return 0644
+	}
+	return t.Perm
+}
+
+// diff returns the files to upload and the remote files missing in the source.
+func (t *Tree) diff(ctx context.Context) ([]string, []string, error) {
+	local, err := t.localFiles()
+	if err != nil {
+		return nil, nil, err
+	}
+	remote, err := t.remoteFiles(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	var changed []string
+	for name, want := range local {
+		if remote[name] != want {
+			changed = append(changed, name)
+		}
+	}
+	var extras []string
+	for name := range remote {
+		if _, ok := local[name]; !ok {
+			extras = append(extras, name)
+		}
+	}
+	sort.Strings(changed)
+	sort.Strings(extras)
+	return changed, extras, nil
+}
+
+func (t *Tree) localFiles() (map[string]treeFile, error) {
+	result := map[string]treeFile{}
+	err := fs.WalkDir(t.Source, ".", func(name string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if entry.IsDir() {
+			return nil
+		}
+		data, err := fs.ReadFile(t.Source, name)
+		if err != nil {
+			return errors.Wrapf(err, "read %s failed", name)
+		}
+		result[name] = treeFile{
+			checksum: fmt.Sprintf("%x", md5.Sum(data)),
+			owner:    fmt.Sprintf("%s:%s", t.User, t.Group),
+			perm:     fmt.Sprintf("%o", uint32(t.perm(name))),
+		}
+		return nil
+	})
+	return result, errors.Wrap(err, "walk source failed")
+}
+
+// remoteFiles lists owner, mode and checksum of all remote files with a single command.
+func (t *Tree) remoteFiles(ctx context.Context) (map[string]treeFile, error) {
+	root, err := t.Path.Path(ctx)
+	if err != nil {
+		return nil, err
+	}
+	stdout, err := t.SSH.RunCommandStdout(ctx, fmt.Sprintf(`if [ -d %s ]; then cd %s && find . -type f -printf "%%u:%%g %%m %%P\n" && echo "--" && find . -type f -exec md5sum {} +; fi`, root, root))
+	if err != nil {
+		return nil, errors.Wrap(err, "list remote files failed")
+	}
+	return parseTreeListing(stdout)
+}
+
+// parseTreeListing parses the output of find -printf "%u:%g %m %P\n" followed by -- and md5sum lines.
+func parseTreeListing(content []byte) (map[string]treeFile, error) {
+	result := map[string]treeFile{}
+	checksums := false
+	for _, line := range strings.Split(string(content), "\n") {
+		if line == "" {
+			continue
+		}
+		if line == "--" {
+			checksums = true
+			continue
+		}
+		if checksums {
+			parts := strings.SplitN(line, "  ", 2)
+			if len(parts) != 2 {
+				return nil, errors.Errorf("invalid checksum line '%s'", line)
+			}
+			name := strings.TrimPrefix(parts[1], "./")
+			entry := result[name]
+			entry.checksum = parts[0]
+			result[name] = entry
+			continue
+		}
+		parts := strings.SplitN(line, " ", 3)
+		if len(parts) != 3 {
+			return nil, errors.Errorf("invalid file line '%s'", line)
+		}
+		entry := result[parts[2]]
+		entry.owner = parts[0]
+		entry.perm = parts[1]
+		result[parts[2]] = entry
+	}
+	return result, nil
+}
+
+func (t *Tree) mkdirCommand(root string, names []string) string {
+	dirs := map[string]bool{root: true}
+	for _, name := range names {
+		dirs[path.Join(root, path.Dir(name))] = true
+	}
+	list := make([]string, 0, len(dirs))
+	for dir := range dirs {
+		list = append(list, fmt.Sprintf("%q", dir))
+	}
+	sort.Strings(list)
+	return fmt.Sprintf("mkdir -p %s", strings.Join(list, " "))
+}
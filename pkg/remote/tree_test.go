@@ -0,0 +1,99 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package remote_test
+
+import (
+	"context"
+	"testing/fstest"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/bborbe/world/pkg/file"
+	"github.com/bborbe/world/pkg/remote"
+	"github.com/bborbe/world/pkg/ssh/sshtest"
+)
+
+var _ = Describe("Tree", func() {
+	var ctx context.Context
+	var recorder *sshtest.Recorder
+	var tree *remote.Tree
+	BeforeEach(func() {
+		ctx = context.Background()
+		recorder = &sshtest.Recorder{}
+		tree = &remote.Tree{
+			SSH: recorder,
+			Source: fstest.MapFS{
+				"index.html":    {Data: []byte("hello")},
+				"bin/run.sh":    {Data: []byte("#!/bin/sh")},
+				"css/style.css": {Data: []byte("body{}")},
+			},
+			Path:  file.Path("/var/www"),
+			User:  "www-data",
+			Group: "www-data",
+			Rules: []remote.TreeRule{
+				{Pattern: "bin/*", Perm: 0755},
+			},
+			Delete: true,
+		}
+	})
+	listing := func(lines string) []sshtest.Response {
+		return []sshtest.Response{{Pattern: `^if \[ -d /var/www \]`, Stdout: []byte(lines)}}
+	}
+	commands := func() []string {
+		var result []string
+		for _, record := range recorder.Records() {
+			result = append(result, record.Command)
+		}
+		return result
+	}
+	Context("remote equals source", func() {
+		BeforeEach(func() {
+			recorder.Responses = listing(`www-data:www-data 644 index.html
+www-data:www-data 755 bin/run.sh
+www-data:www-data 644 css/style.css
+--
+5d41402abc4b2a76b9719d911017c592  ./index.html
+320cce8ccaf83b8487d6a544489d0edc  ./bin/run.sh
+aa676972bbd2b68e94ef8e91e81d20be  ./css/style.css
+`)
+		})
+		It("is satisfied", func() {
+			Expect(tree.Satisfied(ctx)).To(BeTrue())
+		})
+	})
+	Context("remote missing", func() {
+		It("is not satisfied", func() {
+			Expect(tree.Satisfied(ctx)).To(BeFalse())
+		})
+		It("uploads all files", func() {
+			Expect(tree.Apply(ctx)).To(BeNil())
+			Expect(commands()).To(ContainElement(`mkdir -p "/var/www" "/var/www/bin" "/var/www/css"`))
+			Expect(commands()).To(ContainElement("chown www-data:www-data /var/www/bin/.run.sh.world-tmp && chmod 0755 /var/www/bin/.run.sh.world-tmp"))
+			Expect(commands()).To(ContainElement("/var/www/.index.html.world-tmp 0600"))
+			Expect(commands()).To(ContainElement("mv -f /var/www/css/.style.css.world-tmp /var/www/css/style.css"))
+		})
+	})
+	Context("remote partly changed", func() {
+		BeforeEach(func() {
+			recorder.Responses = listing(`www-data:www-data 644 index.html
+www-data:www-data 644 bin/run.sh
+www-data:www-data 644 css/style.css
+www-data:www-data 644 old.html
+--
+5d41402abc4b2a76b9719d911017c592  ./index.html
+cc4cdb3ebe1b1e8fc4cb3b7ee6f5b2a4  ./bin/run.sh
+` + "aa676972bbd2b68e94ef8e91e81d20be  ./css/style.css\n" + `d41d8cd98f00b204e9800998ecf8427e  ./old.html
+`)
+		})
+		It("uploads only changed files and deletes extras", func() {
+			Expect(tree.Apply(ctx)).To(BeNil())
+			Expect(commands()).NotTo(ContainElement("/var/www/.index.html.world-tmp 0600"))
+			Expect(commands()).NotTo(ContainElement("/var/www/css/.style.css.world-tmp 0600"))
+			Expect(commands()).To(ContainElement("chown www-data:www-data /var/www/bin/.run.sh.world-tmp && chmod 0755 /var/www/bin/.run.sh.world-tmp"))
+			Expect(commands()).To(ContainElement(`cd /var/www && rm -f "old.html" && find . -mindepth 1 -type d -empty -delete`))
+		})
+	})
+})
@@ -0,0 +1,180 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package remote
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+
+	"github.com/pkg/errors"
+
+	"github.com/bborbe/world/pkg/content"
+	"github.com/bborbe/world/pkg/file"
+	"github.com/bborbe/world/pkg/ssh"
+	"github.com/bborbe/world/pkg/validation"
+)
+
+// UnitFile is a unit file or drop-in belonging to a unit.
+type UnitFile struct {
+	Path    file.HasPath
+	Content content.HasContent
+}
+
+// Unit writes the unit files and enables and starts the unit.
+// A running unit is only restarted if one of its files changed.
+type Unit struct {
+	SSH    ssh.Executor
+	Name   ServiceName
+	Files  []UnitFile
+	Enable bool
+	Start  bool
+}
+
+func (u *Unit) Satisfied(ctx context.Context) (bool, error) {
+	for _, fileContent := range u.fileContents() {
+		satisfied, err := fileContent.Satisfied(ctx)
+		if err != nil {
+			return false, errors.Wrap(err, "check unit file failed")
+		}
+		if !satisfied {
+			return false, nil
+		}
+	}
+	systemCtl := u.systemCtl()
+	if u.Enable {
+		enabled, err := systemCtl.ServiceEnabled(ctx)
+		if err != nil {
+			return false, errors.Wrap(err, "check unit enabled failed")
+		}
+		if !enabled {
+			return false, nil
+		}
+	}
+	if u.Start {
+		active, err := systemCtl.ServiceActive(ctx)
+		if err != nil {
+			return false, errors.Wrap(err, "check unit active failed")
+		}
+		if !active {
+			return false, nil
+		}
+	}
+	failed, err := systemCtl.ServiceFailed(ctx)
+	if err != nil {
+		return false, errors.Wrap(err, "check unit failed failed")
+	}
+	return !failed, nil
+}
+
+func (u *Unit) Apply(ctx context.Context) error {
+	changed := false
+	for _, fileContent := range u.fileContents() {
+		satisfied, err := fileContent.Satisfied(ctx)
+		if err != nil {
+			return errors.Wrap(err, "check unit file failed")
+		}
+		if satisfied {
+			continue
+		}
+		path, err := fileContent.Path.Path(ctx)
+		if err != nil {
+			return errors.Wrap(err, "get path failed")
+		}
+		if err := u.SSH.RunCommand(ctx, fmt.Sprintf("mkdir -p %s", filepath.Dir(path))); err != nil {
+			return errors.Wrap(err, "create unit directory failed")
+		}
+		if err := fileContent.Apply(ctx); err != nil {
+			return errors.Wrap(err, "write unit file failed")
+		}
+		changed = true
+	}
+	systemCtl := u.systemCtl()
+	if changed {
+		if err := systemCtl.DaemonReload(ctx); err != nil {
+			return errors.Wrap(err, "daemon-reload failed")
+		}
+	}
+	if u.Enable {
+		enabled, err := systemCtl.ServiceEnabled(ctx)
+		if err != nil {
+			return errors.Wrap(err, "check unit enabled failed")
+		}
+		if !enabled {
+			if err := systemCtl.ServiceEnable(ctx); err != nil {
+				return errors.Wrap(err, "enable unit failed")
+			}
+		}
+	}
+	if !u.Start {
+		return nil
+	}
+	active, err := systemCtl.ServiceActive(ctx)
+	if err != nil {
+		return errors.Wrap(err, "check unit active failed")
+	}
+	failed, err := systemCtl.ServiceFailed(ctx)
+	if err != nil {
+		return errors.Wrap(err, "check unit failed failed")
+	}
+	if active && !changed && !failed {
+		return nil
+	}
+	if active || failed {
+		if err := systemCtl.RestartService(ctx); err != nil {
+			return errors.Wrap(err, "restart unit failed")
+		}
+	} else {
+		if err := systemCtl.StartService(ctx); err != nil {
+			return errors.Wrap(err, "start unit failed")
+		}
+	}
+	failed, err = systemCtl.ServiceFailed(ctx)
+	if err != nil {
+		return errors.Wrap(err, "check unit failed failed")
+	}
+	if failed {
+		return errors.Errorf("unit %s failed after start", u.Name)
+	}
+	return nil
+}
+
+func (u *Unit) Validate(ctx context.Context) error {
+	for _, unitFile := range u.Files {
+		if unitFile.Path == nil {
+			return errors.New("Path missing")
+		}
+		if unitFile.Content == nil {
+			return errors.New("Content missing")
+		}
+	}
+	return validation.Validate(
+		ctx,
+		u.SSH,
+		u.Name,
+	)
+}
+
+func (u *Unit) systemCtl() *SystemCtl {
+	return &SystemCtl{
+		SSH:  u.SSH,
+		Name: u.Name,
+	}
+}
+
+func (u *Unit) fileContents() []*FileContent {
+	result := make([]*FileContent, 0, len(u.Files))
+	for _, unitFile := range u.Files {
+		result = append(result, &FileContent{
+			SSH:     u.SSH,
+			Path:    unitFile.Path,
+			Content: unitFile.Content,
+			User:    "root",
+			Group:   "root",
+			Perm:    0644,
+		})
+	}
+	return result
+}
@@ -0,0 +1,97 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package remote_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/bborbe/world/pkg/content"
+	"github.com/bborbe/world/pkg/file"
+	"github.com/bborbe/world/pkg/remote"
+	"github.com/bborbe/world/pkg/ssh/sshtest"
+)
+
+var _ = Describe("Unit", func() {
+	var ctx context.Context
+	var recorder *sshtest.Recorder
+	var unit *remote.Unit
+	BeforeEach(func() {
+		ctx = context.Background()
+		recorder = &sshtest.Recorder{}
+		unit = &remote.Unit{
+			SSH:  recorder,
+			Name: "banana.service",
+			Files: []remote.UnitFile{
+				{
+					Path:    file.Path("/etc/systemd/system/banana.service"),
+					Content: content.Static("[Service]\nExecStart=/bin/true\n"),
+				},
+			},
+			Enable: true,
+			Start:  true,
+		}
+	})
+	commands := func() []string {
+		var result []string
+		for _, record := range recorder.Records() {
+			result = append(result, record.Command)
+		}
+		return result
+	}
+	Context("unit unchanged and running", func() {
+		BeforeEach(func() {
+			recorder.Responses = []sshtest.Response{{Pattern: "is-failed", Fail: true}}
+		})
+		It("is satisfied", func() {
+			Expect(unit.Satisfied(ctx)).To(BeTrue())
+		})
+		It("does not restart", func() {
+			Expect(unit.Apply(ctx)).To(BeNil())
+			Expect(commands()).NotTo(ContainElement("systemctl restart -- banana.service"))
+		})
+	})
+	Context("unit failed", func() {
+		It("is not satisfied", func() {
+			Expect(unit.Satisfied(ctx)).To(BeFalse())
+		})
+	})
+	Context("unit file changed", func() {
+		BeforeEach(func() {
+			recorder.Responses = []sshtest.Response{{Pattern: "md5sum -c|is-failed", Fail: true}}
+		})
+		It("is not satisfied", func() {
+			Expect(unit.Satisfied(ctx)).To(BeFalse())
+		})
+		It("writes file, reloads and restarts", func() {
+			Expect(unit.Apply(ctx)).To(BeNil())
+			Expect(commands()).To(Equal([]string{
+				`echo "704c8348ca5f2662e516c48e11f45b8f /etc/systemd/system/banana.service" | md5sum -c`,
+				"mkdir -p /etc/systemd/system",
+				"/etc/systemd/system/.banana.service.world-tmp 0600",
+				"chown root:root /etc/systemd/system/.banana.service.world-tmp && chmod 0644 /etc/systemd/system/.banana.service.world-tmp",
+				"mv -f /etc/systemd/system/.banana.service.world-tmp /etc/systemd/system/banana.service",
+				"systemctl daemon-reload",
+				"systemctl is-enabled -- banana.service",
+				"systemctl is-active --quiet -- banana.service",
+				"systemctl is-failed --quiet -- banana.service",
+				"systemctl restart -- banana.service",
+				"systemctl is-failed --quiet -- banana.service",
+			}))
+		})
+	})
+	Context("unit not running", func() {
+		BeforeEach(func() {
+			recorder.Responses = []sshtest.Response{{Pattern: "is-active|is-failed", Fail: true}}
+		})
+		It("starts without reload", func() {
+			Expect(unit.Apply(ctx)).To(BeNil())
+			Expect(commands()).To(ContainElement("systemctl start -- banana.service"))
+			Expect(commands()).NotTo(ContainElement("systemctl daemon-reload"))
+		})
+	})
+})
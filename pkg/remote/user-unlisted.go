@@ -0,0 +1,88 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package remote
+
+import (
+	"context"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/bborbe/world/pkg/file"
+	"github.com/bborbe/world/pkg/ssh"
+	"github.com/bborbe/world/pkg/validation"
+)
+
+// UnlistedUsersAbsent removes all regular users (uid 1000-59999) not contained in Users.
+// The connecting user and the user behind sudo are never removed, so the host stays reachable.
+type UnlistedUsersAbsent struct {
+	SSH   ssh.Executor
+	Users []file.User
+}
+
+func (u *UnlistedUsersAbsent) Satisfied(ctx context.Context) (bool, error) {
+	unlisted, err := u.unlisted(ctx)
+	if err != nil {
+		return false, err
+	}
+	return len(unlisted) == 0, nil
+}
+
+func (u *UnlistedUsersAbsent) Apply(ctx context.Context) error {
+	unlisted, err := u.unlisted(ctx)
+	if err != nil {
+		return err
+	}
+	for _, name := range unlisted {
+		userAbsent := &UserAbsent{
+			SSH:  u.SSH,
+			Name: name,
+		}
+		if err := userAbsent.Apply(ctx); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (u *UnlistedUsersAbsent) Validate(ctx context.Context) error {
+	if len(u.Users) == 0 {
+		return errors.New("Users missing")
+	}
+	return validation.Validate(
+		ctx,
+		u.SSH,
+	)
+}
+
+func (u *UnlistedUsersAbsent) unlisted(ctx context.Context) ([]file.User, error) {
+	content, err := u.SSH.RunCommandStdout(ctx, "getent passwd")
+	if err != nil {
+		return nil, errors.Wrap(err, "getent passwd failed")
+	}
+	entries, err := ParsePasswd(content)
+	if err != nil {
+		return nil, err
+	}
+	listed := make(map[string]bool, len(u.Users))
+	for _, user := range u.Users {
+		listed[user.String()] = true
+	}
+	connecting, err := u.SSH.RunCommandStdout(ctx, "echo ${SUDO_USER:-} $(id -un)")
+	if err != nil {
+		return nil, errors.Wrap(err, "get connecting user failed")
+	}
+	for _, name := range strings.Fields(string(connecting)) {
+		listed[name] = true
+	}
+	var result []file.User
+	for _, entry := range entries {
+		if entry.UID < 1000 || entry.UID >= 60000 || listed[entry.Name] {
+			continue
+		}
+		result = append(result, file.User(entry.Name))
+	}
+	return result, nil
+}
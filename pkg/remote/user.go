@@ -0,0 +1,220 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package remote
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/bborbe/world/pkg/file"
+	"github.com/bborbe/world/pkg/ssh"
+	"github.com/bborbe/world/pkg/validation"
+)
+
+// Passwd is a single entry of /etc/passwd.
+type Passwd struct {
+	Name  string
+	UID   int
+	GID   int
+	Home  string
+	Shell string
+}
+
+// ParsePasswd parses the output of getent passwd.
+func ParsePasswd(content []byte) ([]Passwd, error) {
+	var result []Passwd
+	for _, line := range strings.Split(string(content), "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+		parts := strings.Split(line, ":")
+		if len(parts) != 7 {
+			return nil, errors.Errorf("invalid passwd line '%s'", line)
+		}
+		uid, err := strconv.Atoi(parts[2])
+		if err != nil {
+			return nil, errors.Errorf("invalid uid in line '%s'", line)
+		}
+		gid, err := strconv.Atoi(parts[3])
+		if err != nil {
+			return nil, errors.Errorf("invalid gid in line '%s'", line)
+		}
+		result = append(result, Passwd{
+			Name:  parts[0],
+			UID:   uid,
+			GID:   gid,
+			Home:  parts[5],
+			Shell: parts[6],
+		})
+	}
+	return result, nil
+}
+
+// User creates the user or updates uid, primary group, shell and home.
+// Supplementary groups are added, but never removed.
+type User struct {
+	SSH    ssh.Executor
+	Name   file.User
+	UID    int
+	Group  file.Group
+	Groups []file.Group
+	Shell  string
+	Home   string
+}
+
+func (u *User) Satisfied(ctx context.Context) (bool, error) {
+	passwd, err := u.passwd(ctx)
+	if err != nil {
+		return false, err
+	}
+	if passwd == nil {
+		return false, nil
+	}
+	if u.UID != 0 && passwd.UID != u.UID {
+		return false, nil
+	}
+	if passwd.Shell != u.shell() || passwd.Home != u.home() {
+		return false, nil
+	}
+	groups, err := u.SSH.RunCommandStdout(ctx, fmt.Sprintf("id -Gn %s", u.Name))
+	if err != nil {
+		return false, errors.Wrap(err, "get groups failed")
+	}
+	fields := strings.Fields(string(groups))
+	if u.Group != "" && (len(fields) == 0 || fields[0] != u.Group.String()) {
+		return false, nil
+	}
+	for _, group := range u.Groups {
+		if !containsString(fields, group.String()) {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+func (u *User) Apply(ctx context.Context) error {
+	passwd, err := u.passwd(ctx)
+	if err != nil {
+		return err
+	}
+	buf := &bytes.Buffer{}
+	if passwd == nil {
+		fmt.Fprintf(buf, "useradd --create-home --home-dir %s", u.home())
+	} else {
+		fmt.Fprintf(buf, "usermod --home %s", u.home())
+	}
+	fmt.Fprintf(buf, " --shell %s", u.shell())
+	if u.UID != 0 {
+		fmt.Fprintf(buf, " --uid %d", u.UID)
+	}
+	if u.Group != "" {
+		fmt.Fprintf(buf, " --gid %s", u.Group)
+	} else if passwd == nil {
+		fmt.Fprint(buf, " --user-group")
+	}
+	if len(u.Groups) > 0 {
+		groups := make([]string, 0, len(u.Groups))
+		for _, group := range u.Groups {
+			groups = append(groups, group.String())
+		}
+		if passwd != nil {
+			fmt.Fprint(buf, " --append")
+		}
+		fmt.Fprintf(buf, " --groups %s", strings.Join(groups, ","))
+	}
+	fmt.Fprintf(buf, " %s", u.Name)
+	return errors.Wrapf(u.SSH.RunCommand(ctx, buf.String()), "create or update user %s failed", u.Name)
+}
+
+func (u *User) Validate(ctx context.Context) error {
+	if u.Name == "" {
+		return errors.New("Name missing")
+	}
+	if u.UID < 0 {
+		return errors.New("UID invalid")
+	}
+	return validation.Validate(
+		ctx,
+		u.SSH,
+		u.Name,
+	)
+}
+
+func (u *User) passwd(ctx context.Context) (*Passwd, error) {
+	return getentPasswd(ctx, u.SSH, u.Name)
+}
+
+func (u *User) shell() string {
+	if u.Shell == "" {
+		return "/bin/bash"
+	}
+	return u.Shell
+}
+
+func (u *User) home() string {
+	if u.Home == "" {
+		return fmt.Sprintf("/home/%s", u.Name)
+	}
+	return u.Home
+}
+
+// UserAbsent removes the user including its home directory.
+type UserAbsent struct {
+	SSH  ssh.Executor
+	Name file.User
+}
+
+func (u *UserAbsent) Satisfied(ctx context.Context) (bool, error) {
+	passwd, err := getentPasswd(ctx, u.SSH, u.Name)
+	if err != nil {
+		return false, err
+	}
+	return passwd == nil, nil
+}
+
+func (u *UserAbsent) Apply(ctx context.Context) error {
+	return errors.Wrapf(u.SSH.RunCommand(ctx, fmt.Sprintf("userdel --remove %s", u.Name)), "remove user %s failed", u.Name)
+}
+
+func (u *UserAbsent) Validate(ctx context.Context) error {
+	if u.Name == "" {
+		return errors.New("Name missing")
+	}
+	return validation.Validate(
+		ctx,
+		u.SSH,
+		u.Name,
+	)
+}
+
+func getentPasswd(ctx context.Context, executor ssh.Executor, name file.User) (*Passwd, error) {
+	content, err := executor.RunCommandStdout(ctx, fmt.Sprintf("getent passwd %s || true", name))
+	if err != nil {
+		return nil, errors.Wrap(err, "getent passwd failed")
+	}
+	entries, err := ParsePasswd(content)
+	if err != nil {
+		return nil, err
+	}
+	if len(entries) == 0 {
+		return nil, nil
+	}
+	return &entries[0], nil
+}
+
+func containsString(list []string, value string) bool {
+	for _, item := range list {
+		if item == value {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,101 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package remote_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/bborbe/world/pkg/file"
+	"github.com/bborbe/world/pkg/remote"
+	"github.com/bborbe/world/pkg/ssh/sshtest"
+)
+
+var _ = Describe("User", func() {
+	var ctx context.Context
+	var recorder *sshtest.Recorder
+	var user *remote.User
+	BeforeEach(func() {
+		ctx = context.Background()
+		recorder = &sshtest.Recorder{}
+		user = &remote.User{
+			SSH:    recorder,
+			Name:   "alice",
+			UID:    1001,
+			Groups: []file.Group{"sudo", "docker"},
+		}
+	})
+	lastCommand := func() string {
+		records := recorder.Records()
+		return records[len(records)-1].Command
+	}
+	Context("user missing", func() {
+		It("is not satisfied", func() {
+			Expect(user.Satisfied(ctx)).To(BeFalse())
+		})
+		It("creates user", func() {
+			Expect(user.Apply(ctx)).To(BeNil())
+			Expect(lastCommand()).To(Equal("useradd --create-home --home-dir /home/alice --shell /bin/bash --uid 1001 --user-group --groups sudo,docker alice"))
+		})
+	})
+	Context("user exists", func() {
+		BeforeEach(func() {
+			recorder.Responses = []sshtest.Response{
+				{Pattern: "^getent passwd", Stdout: []byte("alice:x:1001:1001:,,,:/home/alice:/bin/bash\n")},
+				{Pattern: "^id -Gn", Stdout: []byte("alice adm sudo docker\n")},
+			}
+		})
+		It("is satisfied", func() {
+			Expect(user.Satisfied(ctx)).To(BeTrue())
+		})
+		It("is not satisfied with other shell", func() {
+			user.Shell = "/bin/zsh"
+			Expect(user.Satisfied(ctx)).To(BeFalse())
+		})
+		It("is not satisfied with missing group", func() {
+			user.Groups = append(user.Groups, "video")
+			Expect(user.Satisfied(ctx)).To(BeFalse())
+		})
+		It("updates user and keeps other groups", func() {
+			Expect(user.Apply(ctx)).To(BeNil())
+			Expect(lastCommand()).To(Equal("usermod --home /home/alice --shell /bin/bash --uid 1001 --append --groups sudo,docker alice"))
+		})
+	})
+})
+
+var _ = Describe("UnlistedUsersAbsent", func() {
+	var ctx context.Context
+	var recorder *sshtest.Recorder
+	var unlistedUsersAbsent *remote.UnlistedUsersAbsent
+	BeforeEach(func() {
+		ctx = context.Background()
+		recorder = &sshtest.Recorder{
+			Responses: []sshtest.Response{
+				{Pattern: "^getent passwd$", Stdout: []byte(`root:x:0:0:root:/root:/bin/bash
+nobody:x:65534:65534:nobody:/nonexistent:/usr/sbin/nologin
+alice:x:1001:1001:,,,:/home/alice:/bin/bash
+bob:x:1002:1002:,,,:/home/bob:/bin/bash
+bborbe:x:1003:1003:,,,:/home/bborbe:/bin/bash
+`)},
+				{Pattern: "SUDO_USER", Stdout: []byte("bborbe root\n")},
+			},
+		}
+		unlistedUsersAbsent = &remote.UnlistedUsersAbsent{
+			SSH:   recorder,
+			Users: []file.User{"alice"},
+		}
+	})
+	It("is not satisfied", func() {
+		Expect(unlistedUsersAbsent.Satisfied(ctx)).To(BeFalse())
+	})
+	It("removes only unlisted regular users except the connecting user", func() {
+		Expect(unlistedUsersAbsent.Apply(ctx)).To(BeNil())
+		records := recorder.Records()
+		Expect(records).To(HaveLen(3))
+		Expect(records[2].Command).To(Equal("userdel --remove bob"))
+	})
+})
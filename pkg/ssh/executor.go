@@ -0,0 +1,24 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package ssh
+
+import (
+	"context"
+	"os"
+)
+
+// Executor runs commands and writes files on a host.
+//
+//go:generate go run -mod=vendor github.com/maxbrunsfeld/counterfeiter/v6 -o ../../mocks/executor.go --fake-name Executor . Executor
+type Executor interface {
+	RunCommand(ctx context.Context, cmd string) error
+	RunCommandStdin(ctx context.Context, command string, content []byte) error
+	RunCommandStdout(ctx context.Context, command string) ([]byte, error)
+	CopyFile(ctx context.Context, path string, content []byte, perm os.FileMode) error
+	Validate(ctx context.Context) error
+	Close()
+}
+
+var _ Executor = &SSH{}
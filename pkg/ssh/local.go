@@ -0,0 +1,70 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package ssh
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+
+	"github.com/golang/glog"
+	"github.com/pkg/errors"
+)
+
+// Local executes all commands on the current machine.
+type Local struct {
+	// Sudo runs every command with sudo like SSH does.
+	Sudo bool
+}
+
+var _ Executor = &Local{}
+
+func (l *Local) RunCommand(ctx context.Context, cmd string) error {
+	return errors.Wrapf(l.command(ctx, cmd).Run(), "run command failed: %s", cmd)
+}
+
+func (l *Local) RunCommandStdin(ctx context.Context, command string, content []byte) error {
+	cmd := l.command(ctx, command)
+	cmd.Stdin = bytes.NewReader(content)
+	return errors.Wrapf(cmd.Run(), "run command failed: %s", command)
+}
+
+func (l *Local) RunCommandStdout(ctx context.Context, command string) ([]byte, error) {
+	stdout, err := l.command(ctx, command).Output()
+	if err != nil {
+		return nil, errors.Wrapf(err, "run command failed: %s", command)
+	}
+	return stdout, nil
+}
+
+func (l *Local) CopyFile(ctx context.Context, path string, content []byte, perm os.FileMode) error {
+	return errors.Wrapf(
+		l.RunCommandStdin(ctx, fmt.Sprintf("cat > %s && chmod %04o %s", path, perm.Perm(), path), content),
+		"copy file to %s failed", path,
+	)
+}
+
+func (l *Local) Validate(ctx context.Context) error {
+	return nil
+}
+
+// Key identifies the current machine, like local.
+func (l *Local) Key(ctx context.Context) (string, error) {
+	return "local", nil
+}
+
+// Close does nothing, no connection is kept.
+func (l *Local) Close() {}
+
+func (l *Local) command(ctx context.Context, cmd string) *exec.Cmd {
+	command := "export LANG=C; " + cmd
+	glog.V(1).Infof("run local command: %s", command)
+	if l.Sudo {
+		return exec.CommandContext(ctx, "sudo", "sh", "-c", command)
+	}
+	return exec.CommandContext(ctx, "sh", "-c", command)
+}
@@ -0,0 +1,52 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package ssh_test
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/bborbe/world/pkg/ssh"
+)
+
+var _ = Describe("Local", func() {
+	var ctx context.Context
+	var local *ssh.Local
+	var dir string
+	BeforeEach(func() {
+		ctx = context.Background()
+		local = &ssh.Local{}
+		var err error
+		dir, err = ioutil.TempDir("", "world-local")
+		Expect(err).To(BeNil())
+	})
+	AfterEach(func() {
+		_ = os.RemoveAll(dir)
+	})
+	It("runs command", func() {
+		Expect(local.RunCommand(ctx, "true")).To(BeNil())
+		Expect(local.RunCommand(ctx, "false")).NotTo(BeNil())
+	})
+	It("returns stdout", func() {
+		stdout, err := local.RunCommandStdout(ctx, "echo hello")
+		Expect(err).To(BeNil())
+		Expect(string(stdout)).To(Equal("hello\n"))
+	})
+	It("copies file", func() {
+		path := filepath.Join(dir, "banana.txt")
+		Expect(local.CopyFile(ctx, path, []byte("yellow"), 0600)).To(BeNil())
+		content, err := ioutil.ReadFile(path)
+		Expect(err).To(BeNil())
+		Expect(string(content)).To(Equal("yellow"))
+		info, err := os.Stat(path)
+		Expect(err).To(BeNil())
+		Expect(info.Mode().Perm()).To(Equal(os.FileMode(0600)))
+	})
+})
@@ -0,0 +1,181 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package ssh
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/golang/glog"
+	"github.com/pkg/errors"
+	"golang.org/x/crypto/ssh"
+)
+
+// DefaultPool is used by all SSH without a own Pool.
+var DefaultPool = NewPool()
+
+// NewPool returns a Pool with keepalive every 30 seconds and at most 8 concurrent sessions per host.
+func NewPool() *Pool {
+	return &Pool{
+		KeepAliveInterval: 30 * time.Second,
+		MaxSessions:       8,
+	}
+}
+
+// Pool shares one connection per host and user between all SSH values.
+type Pool struct {
+	KeepAliveInterval time.Duration
+	MaxSessions       int
+
+	mux   sync.Mutex
+	hosts map[string]*poolHost
+}
+
+type dialFunc func(ctx context.Context) (*ssh.Client, error)
+
+// Session opens a new session on the connection of the given key. The returned func must
+// be called after the session is closed to free the slot for the next session.
+func (p *Pool) Session(ctx context.Context, key string, dial dialFunc) (*ssh.Session, func(), error) {
+	host := p.host(key)
+	select {
+	case <-ctx.Done():
+		return nil, nil, ctx.Err()
+	case host.slots <- struct{}{}:
+	}
+	release := func() {
+		<-host.slots
+	}
+	session, err := host.newSession(ctx, dial)
+	if err != nil {
+		release()
+		return nil, nil, err
+	}
+	return session, release, nil
+}
+
+// CloseHost closes the connection of the given key.
+func (p *Pool) CloseHost(key string) {
+	p.mux.Lock()
+	host, ok := p.hosts[key]
+	p.mux.Unlock()
+	if ok {
+		host.close()
+	}
+}
+
+// Close closes all connections of the pool.
+func (p *Pool) Close() {
+	p.mux.Lock()
+	defer p.mux.Unlock()
+	for key, host := range p.hosts {
+		glog.V(2).Infof("close connection to %s", key)
+		host.close()
+	}
+}
+
+func (p *Pool) host(key string) *poolHost {
+	p.mux.Lock()
+	defer p.mux.Unlock()
+	if p.hosts == nil {
+		p.hosts = make(map[string]*poolHost)
+	}
+	host, ok := p.hosts[key]
+	if !ok {
+		maxSessions := p.MaxSessions
+		if maxSessions <= 0 {
+			maxSessions = 1
+		}
+		host = &poolHost{
+			key:               key,
+			keepAliveInterval: p.KeepAliveInterval,
+			slots:             make(chan struct{}, maxSessions),
+		}
+		p.hosts[key] = host
+	}
+	return host
+}
+
+type poolHost struct {
+	key               string
+	keepAliveInterval time.Duration
+	slots             chan struct{}
+
+	mux    sync.Mutex
+	client *ssh.Client
+}
+
+// newSession creates a session and reconnects once if the connection is broken.
+func (h *poolHost) newSession(ctx context.Context, dial dialFunc) (*ssh.Session, error) {
+	client, err := h.getClient(ctx, dial)
+	if err != nil {
+		return nil, errors.Wrap(err, "get client failed")
+	}
+	session, err := client.NewSession()
+	if err == nil {
+		return session, nil
+	}
+	glog.V(2).Infof("create session to %s failed => reconnect: %v", h.key, err)
+	h.reset(client)
+	client, err = h.getClient(ctx, dial)
+	if err != nil {
+		return nil, errors.Wrap(err, "reconnect failed")
+	}
+	session, err = client.NewSession()
+	if err != nil {
+		return nil, errors.Wrap(err, "create new ssh session failed")
+	}
+	return session, nil
+}
+
+func (h *poolHost) getClient(ctx context.Context, dial dialFunc) (*ssh.Client, error) {
+	h.mux.Lock()
+	defer h.mux.Unlock()
+
+	if h.client == nil {
+		client, err := dial(ctx)
+		if err != nil {
+			return nil, err
+		}
+		glog.V(2).Infof("connected to %s", h.key)
+		h.client = client
+		if h.keepAliveInterval > 0 {
+			go h.keepAlive(client)
+		}
+	}
+	return h.client, nil
+}
+
+// keepAlive sends keepalive requests until the connection is closed or broken.
+func (h *poolHost) keepAlive(client *ssh.Client) {
+	ticker := time.NewTicker(h.keepAliveInterval)
+	defer ticker.Stop()
+	for range ticker.C {
+		if _, _, err := client.SendRequest("keepalive@openssh.com", true, nil); err != nil {
+			glog.V(2).Infof("keepalive to %s failed => drop connection: %v", h.key, err)
+			h.reset(client)
+			return
+		}
+	}
+}
+
+// reset drops the given client, if it is still the current one.
+func (h *poolHost) reset(client *ssh.Client) {
+	h.mux.Lock()
+	if h.client == client {
+		h.client = nil
+	}
+	h.mux.Unlock()
+	client.Close()
+}
+
+func (h *poolHost) close() {
+	h.mux.Lock()
+	defer h.mux.Unlock()
+	if h.client != nil {
+		h.client.Close()
+		h.client = nil
+	}
+}
@@ -0,0 +1,145 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package ssh_test
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"net"
+	"sync"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	cryptossh "golang.org/x/crypto/ssh"
+
+	"github.com/bborbe/world/pkg/ssh"
+)
+
+// testServer accepts ssh connections without authentication and opens all sessions.
+type testServer struct {
+	listener net.Listener
+	config   *cryptossh.ServerConfig
+
+	mux   sync.Mutex
+	conns []net.Conn
+}
+
+func newTestServer() *testServer {
+	_, key, err := ed25519.GenerateKey(rand.Reader)
+	Expect(err).To(BeNil())
+	signer, err := cryptossh.NewSignerFromKey(key)
+	Expect(err).To(BeNil())
+	config := &cryptossh.ServerConfig{NoClientAuth: true}
+	config.AddHostKey(signer)
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	Expect(err).To(BeNil())
+	server := &testServer{
+		listener: listener,
+		config:   config,
+	}
+	go server.serve()
+	return server
+}
+
+func (s *testServer) serve() {
+	for {
+		conn, err := s.listener.Accept()
+		if err != nil {
+			return
+		}
+		s.mux.Lock()
+		s.conns = append(s.conns, conn)
+		s.mux.Unlock()
+		go s.handle(conn)
+	}
+}
+
+func (s *testServer) handle(conn net.Conn) {
+	_, channels, requests, err := cryptossh.NewServerConn(conn, s.config)
+	if err != nil {
+		return
+	}
+	go cryptossh.DiscardRequests(requests)
+	for newChannel := range channels {
+		channel, channelRequests, err := newChannel.Accept()
+		if err != nil {
+			continue
+		}
+		go cryptossh.DiscardRequests(channelRequests)
+		go channel.Close()
+	}
+}
+
+// dropConnections closes all connections from the server side.
+func (s *testServer) dropConnections() {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+	for _, conn := range s.conns {
+		conn.Close()
+	}
+	s.conns = nil
+}
+
+func (s *testServer) close() {
+	s.listener.Close()
+	s.dropConnections()
+}
+
+var _ = Describe("Pool", func() {
+	var ctx context.Context
+	var server *testServer
+	var pool *ssh.Pool
+	var dials int
+	var clients []*cryptossh.Client
+	var dial func(ctx context.Context) (*cryptossh.Client, error)
+	BeforeEach(func() {
+		ctx = context.Background()
+		server = newTestServer()
+		pool = ssh.NewPool()
+		dials = 0
+		clients = nil
+		dial = func(ctx context.Context) (*cryptossh.Client, error) {
+			dials++
+			client, err := cryptossh.Dial("tcp", server.listener.Addr().String(), &cryptossh.ClientConfig{
+				User:            "test",
+				HostKeyCallback: cryptossh.InsecureIgnoreHostKey(),
+			})
+			if err == nil {
+				clients = append(clients, client)
+			}
+			return client, err
+		}
+	})
+	AfterEach(func() {
+		pool.Close()
+		server.close()
+	})
+	newSession := func() {
+		session, release, err := pool.Session(ctx, "test@host", dial)
+		Expect(err).To(BeNil())
+		session.Close()
+		release()
+	}
+	It("reuses the connection", func() {
+		newSession()
+		newSession()
+		Expect(dials).To(Equal(1))
+	})
+	It("reconnects after the connection is dead", func() {
+		newSession()
+		server.dropConnections()
+		Expect(clients[0].Wait()).NotTo(BeNil())
+		newSession()
+		Expect(dials).To(Equal(2))
+	})
+	It("closes all connections", func() {
+		newSession()
+		pool.Close()
+		Expect(clients[0].Wait()).NotTo(BeNil())
+		newSession()
+		Expect(dials).To(Equal(2))
+	})
+})
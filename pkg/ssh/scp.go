@@ -0,0 +1,95 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package ssh
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/golang/glog"
+	"github.com/pkg/errors"
+)
+
+// CopyFile writes content to the given remote path using the scp sink protocol.
+// The file is created with the given permissions.
+func (s *SSH) CopyFile(ctx context.Context, path string, content []byte, perm os.FileMode) error {
+	session, release, err := s.createSession(ctx)
+	if err != nil {
+		return errors.Wrap(err, "create ssh session failed")
+	}
+	defer release()
+	defer session.Close()
+
+	stdin, err := session.StdinPipe()
+	if err != nil {
+		return errors.Wrap(err, "open stdinpipe failed")
+	}
+	defer stdin.Close()
+	stdout, err := session.StdoutPipe()
+	if err != nil {
+		return errors.Wrap(err, "open stdoutpipe failed")
+	}
+	reader := bufio.NewReader(stdout)
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	default:
+	}
+
+	command := buildCommand(fmt.Sprintf("scp -qt %s", path))
+	glog.V(1).Infof("run remote command: %s", command)
+	if err := session.Start(command); err != nil {
+		return errors.Wrapf(err, "start command failed: %s", command)
+	}
+	if err := readScpAck(reader); err != nil {
+		return errors.Wrap(err, "scp not ready")
+	}
+	if _, err := fmt.Fprintf(stdin, "C%04o %d %s\n", perm.Perm(), len(content), filepath.Base(path)); err != nil {
+		return errors.Wrap(err, "write scp header failed")
+	}
+	if err := readScpAck(reader); err != nil {
+		return errors.Wrap(err, "scp header rejected")
+	}
+	if _, err := stdin.Write(content); err != nil {
+		return errors.Wrap(err, "write content failed")
+	}
+	if _, err := stdin.Write([]byte{0}); err != nil {
+		return errors.Wrap(err, "write content end failed")
+	}
+	if err := readScpAck(reader); err != nil {
+		return errors.Wrap(err, "scp content rejected")
+	}
+	stdin.Close()
+	if err := session.Wait(); err != nil {
+		return errors.Wrapf(err, "run command failed: %s", command)
+	}
+	glog.V(2).Infof("copy %d bytes to %s complete", len(content), path)
+	return nil
+}
+
+// readScpAck reads one response of the remote scp. 0 is success, 1 a warning and 2 a fatal error,
+// both followed by a message line.
+func readScpAck(reader *bufio.Reader) error {
+	code, err := reader.ReadByte()
+	if err != nil {
+		if err == io.EOF {
+			return errors.New("scp closed connection")
+		}
+		return errors.Wrap(err, "read scp response failed")
+	}
+	if code == 0 {
+		return nil
+	}
+	message, err := reader.ReadString('\n')
+	if err != nil && err != io.EOF {
+		return errors.Wrap(err, "read scp message failed")
+	}
+	return errors.Errorf("scp failed with code %d: %s", code, message)
+}
@@ -11,6 +11,7 @@ import (
 	"io"
 	"io/ioutil"
 	"sync"
+	"time"
 
 	"github.com/bborbe/run"
 	"github.com/golang/glog"
@@ -98,9 +99,10 @@ type SSH struct {
 	Host           Host
 	PrivateKeyPath PrivateKeyPath
 	User           User
+	Pool           *Pool
 
-	mux    sync.Mutex
-	client *ssh.Client
+	mux sync.Mutex
+	key string
 }
 
 func (s *SSH) Validate(ctx context.Context) error {
@@ -116,51 +118,69 @@ func (s *SSH) Validate(ctx context.Context) error {
 	return nil
 }
 
-func (s *SSH) getClient(ctx context.Context) (*ssh.Client, error) {
+func (s *SSH) pool() *Pool {
+	if s.Pool == nil {
+		return DefaultPool
+	}
+	return s.Pool
+}
+
+// Key identifies the host and user of the connection, like bborbe@192.168.178.2:22.
+func (s *SSH) Key(ctx context.Context) (string, error) {
 	s.mux.Lock()
 	defer s.mux.Unlock()
 
-	if s.client == nil {
-		signer, err := s.PrivateKeyPath.Signer()
-		if err != nil {
-			return nil, errors.Wrap(err, "get signer failed")
-		}
+	if s.key == "" {
 		addr, err := s.Host.Address(ctx)
 		if err != nil {
-			return nil, errors.Wrap(err, "get addr from host failed")
-		}
-		s.client, err = ssh.Dial("tcp", addr, &ssh.ClientConfig{
-			User: s.User.String(),
-			Auth: []ssh.AuthMethod{
-				ssh.PublicKeys(signer),
-			},
-			HostKeyCallback: ssh.InsecureIgnoreHostKey(),
-		})
-		if err != nil {
-			return nil, errors.Wrap(err, "connect host failed")
+			return "", errors.Wrap(err, "get addr from host failed")
 		}
+		s.key = fmt.Sprintf("%s@%s", s.User, addr)
 	}
+	return s.key, nil
+}
 
-	return s.client, nil
+func (s *SSH) dial(ctx context.Context) (*ssh.Client, error) {
+	signer, err := s.PrivateKeyPath.Signer()
+	if err != nil {
+		return nil, errors.Wrap(err, "get signer failed")
+	}
+	addr, err := s.Host.Address(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "get addr from host failed")
+	}
+	client, err := ssh.Dial("tcp", addr, &ssh.ClientConfig{
+		User: s.User.String(),
+		Auth: []ssh.AuthMethod{
+			ssh.PublicKeys(signer),
+		},
+		HostKeyCallback: ssh.InsecureIgnoreHostKey(),
+		Timeout:         30 * time.Second,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "connect host failed")
+	}
+	return client, nil
 }
 
-func (s *SSH) createSession(ctx context.Context) (*ssh.Session, error) {
-	client, err := s.getClient(ctx)
+func (s *SSH) createSession(ctx context.Context) (*ssh.Session, func(), error) {
+	key, err := s.Key(ctx)
 	if err != nil {
-		return nil, errors.Wrap(err, "get client failed")
+		return nil, nil, err
 	}
-	session, err := client.NewSession()
+	session, release, err := s.pool().Session(ctx, key, s.dial)
 	if err != nil {
-		return nil, errors.Wrap(err, "create new ssh session failed")
+		return nil, nil, errors.Wrap(err, "create new ssh session failed")
 	}
-	return session, nil
+	return session, release, nil
 }
 
 func (s *SSH) RunCommandStdin(ctx context.Context, command string, content []byte) error {
-	session, err := s.createSession(ctx)
+	session, release, err := s.createSession(ctx)
 	if err != nil {
 		return errors.Wrap(err, "create ssh session failed")
 	}
+	defer release()
 	defer session.Close()
 
 	return run.CancelOnFirstError(
@@ -182,10 +202,11 @@ func (s *SSH) RunCommandStdin(ctx context.Context, command string, content []byt
 }
 
 func (s *SSH) RunCommandStdout(ctx context.Context, command string) ([]byte, error) {
-	session, err := s.createSession(ctx)
+	session, release, err := s.createSession(ctx)
 	if err != nil {
 		return nil, errors.Wrap(err, "create ssh session failed")
 	}
+	defer release()
 	defer session.Close()
 
 	b := &bytes.Buffer{}
@@ -210,16 +231,21 @@ func (s *SSH) RunCommandStdout(ctx context.Context, command string) ([]byte, err
 }
 
 func (s *SSH) RunCommand(ctx context.Context, cmd string) error {
-	session, err := s.createSession(ctx)
+	session, release, err := s.createSession(ctx)
 	if err != nil {
 		return errors.Wrap(err, "create ssh session failed")
 	}
+	defer release()
 	defer session.Close()
 	return runWithout(ctx, session, cmd)
 }
 
+func buildCommand(cmd string) string {
+	return fmt.Sprintf("sudo sh -c 'export LANG=C; %s'", cmd)
+}
+
 func runWithout(ctx context.Context, session *ssh.Session, cmd string) error {
-	command := fmt.Sprintf("sudo sh -c 'export LANG=C; %s'", cmd)
+	command := buildCommand(cmd)
 	glog.V(1).Infof("run remote command: %s", command)
 	select {
 	case <-ctx.Done():
@@ -230,10 +256,13 @@ func runWithout(ctx context.Context, session *ssh.Session, cmd string) error {
 	}
 }
 
+// Close closes the shared connection to the host.
 func (s *SSH) Close() {
 	s.mux.Lock()
-	defer s.mux.Unlock()
+	key := s.key
+	s.mux.Unlock()
 
-	s.client.Close()
-	s.client = nil
+	if key != "" {
+		s.pool().CloseHost(key)
+	}
 }
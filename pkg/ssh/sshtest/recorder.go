@@ -0,0 +1,142 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package sshtest provides an ssh.Executor recording all commands for tests.
+package sshtest
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"regexp"
+	"strings"
+	"sync"
+
+	"github.com/pkg/errors"
+
+	"github.com/bborbe/world/pkg/ssh"
+)
+
+// Response is the scripted answer of the Recorder for all commands matching the Pattern.
+type Response struct {
+	Pattern string
+	Stdout  []byte
+	Fail    bool
+}
+
+// Record is one command received by the Recorder.
+type Record struct {
+	Method  string
+	Command string
+	Content []byte
+}
+
+func (r Record) String() string {
+	buf := &bytes.Buffer{}
+	fmt.Fprintf(buf, "%s: %s\n", r.Method, r.Command)
+	if len(r.Content) > 0 {
+		for _, line := range strings.Split(strings.TrimSuffix(string(r.Content), "\n"), "\n") {
+			fmt.Fprintf(buf, "> %s\n", line)
+		}
+	}
+	return buf.String()
+}
+
+// Recorder is an Executor for tests. It records all commands and answers them with the
+// first matching Response. Commands without matching Response succeed with empty stdout.
+type Recorder struct {
+	// Host is returned as Key, a unique key per Recorder if empty.
+	Host      string
+	Responses []Response
+
+	mux     sync.Mutex
+	records []Record
+}
+
+var _ ssh.Executor = &Recorder{}
+
+func (r *Recorder) RunCommand(ctx context.Context, cmd string) error {
+	_, err := r.record(Record{Method: "run", Command: cmd})
+	return err
+}
+
+func (r *Recorder) RunCommandStdin(ctx context.Context, command string, content []byte) error {
+	_, err := r.record(Record{Method: "stdin", Command: command, Content: content})
+	return err
+}
+
+func (r *Recorder) RunCommandStdout(ctx context.Context, command string) ([]byte, error) {
+	return r.record(Record{Method: "stdout", Command: command})
+}
+
+func (r *Recorder) CopyFile(ctx context.Context, path string, content []byte, perm os.FileMode) error {
+	_, err := r.record(Record{Method: "copy", Command: fmt.Sprintf("%s %04o", path, perm.Perm()), Content: content})
+	return err
+}
+
+func (r *Recorder) Validate(ctx context.Context) error {
+	return nil
+}
+
+func (r *Recorder) Close() {}
+
+func (r *Recorder) Key(ctx context.Context) (string, error) {
+	if r.Host != "" {
+		return r.Host, nil
+	}
+	return fmt.Sprintf("%p", r), nil
+}
+
+// Records returns all recorded commands in order.
+func (r *Recorder) Records() []Record {
+	r.mux.Lock()
+	defer r.mux.Unlock()
+	return append([]Record{}, r.records...)
+}
+
+// Transcript returns all recorded commands as text.
+func (r *Recorder) Transcript() string {
+	buf := &bytes.Buffer{}
+	for _, record := range r.Records() {
+		buf.WriteString(record.String())
+	}
+	return buf.String()
+}
+
+func (r *Recorder) record(record Record) ([]byte, error) {
+	r.mux.Lock()
+	defer r.mux.Unlock()
+	r.records = append(r.records, record)
+	for _, response := range r.Responses {
+		match, err := regexp.MatchString(response.Pattern, record.Command)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid pattern %s", response.Pattern)
+		}
+		if !match {
+			continue
+		}
+		if response.Fail {
+			return response.Stdout, errors.Errorf("run command failed: %s", record.Command)
+		}
+		return response.Stdout, nil
+	}
+	return nil, nil
+}
+
+// ReadGolden returns the content of the given golden file.
+// If UPDATE_GOLDEN is set, the golden file is replaced with the actual transcript first.
+func ReadGolden(path string, actual string) (string, error) {
+	if os.Getenv("UPDATE_GOLDEN") != "" {
+		if err := ioutil.WriteFile(path, []byte(actual), 0644); err != nil {
+			return "", errors.Wrapf(err, "write golden %s failed", path)
+		}
+	}
+	content, err := ioutil.ReadFile(path)
+	if err != nil {
+		return "", errors.Wrapf(err, "read golden %s failed", path)
+	}
+	return string(content), nil
+}
@@ -0,0 +1,298 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package systemd
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const UnitDirectory = "/etc/systemd/system"
+
+// Option is a single key value line of a section.
+type Option struct {
+	Key   string
+	Value string
+}
+
+type Options []Option
+
+func (o Options) add(key string, values ...string) Options {
+	for _, value := range values {
+		if value == "" {
+			continue
+		}
+		o = append(o, Option{Key: key, Value: value})
+	}
+	return o
+}
+
+func (o Options) addBool(key string, value bool) Options {
+	if !value {
+		return o
+	}
+	return o.add(key, "true")
+}
+
+func (o Options) addDuration(key string, value time.Duration) Options {
+	if value == 0 {
+		return o
+	}
+	return o.add(key, Duration(value))
+}
+
+// Duration formats the given duration as systemd time span.
+func Duration(d time.Duration) string {
+	if d%time.Second == 0 {
+		return fmt.Sprintf("%ds", d/time.Second)
+	}
+	return fmt.Sprintf("%dms", d/time.Millisecond)
+}
+
+type UnitSection struct {
+	Description   string
+	Documentation []string
+	Requires      []string
+	Wants         []string
+	After         []string
+	Before        []string
+	OnFailure     []string
+	Extra         Options
+}
+
+func (u UnitSection) options() Options {
+	var result Options
+	result = result.add("Description", u.Description)
+	result = result.add("Documentation", u.Documentation...)
+	result = result.add("Requires", u.Requires...)
+	result = result.add("Wants", u.Wants...)
+	result = result.add("After", u.After...)
+	result = result.add("Before", u.Before...)
+	result = result.add("OnFailure", u.OnFailure...)
+	return append(result, u.Extra...)
+}
+
+type ServiceSection struct {
+	Type             string
+	User             string
+	Group            string
+	WorkingDirectory string
+	Environment      []string
+	EnvironmentFile  []string
+	ExecStartPre     []string
+	ExecStart        []string
+	ExecStartPost    []string
+	ExecReload       []string
+	ExecStop         []string
+	Restart          string
+	RestartSec       time.Duration
+	TimeoutStartSec  time.Duration
+	TimeoutStopSec   time.Duration
+	StandardOutput   string
+	StandardError    string
+	SyslogIdentifier string
+	Extra            Options
+}
+
+func (s ServiceSection) options() Options {
+	var result Options
+	result = result.add("Type", s.Type)
+	result = result.add("User", s.User)
+	result = result.add("Group", s.Group)
+	result = result.add("WorkingDirectory", s.WorkingDirectory)
+	result = result.add("Environment", s.Environment...)
+	result = result.add("EnvironmentFile", s.EnvironmentFile...)
+	result = result.add("ExecStartPre", s.ExecStartPre...)
+	result = result.add("ExecStart", s.ExecStart...)
+	result = result.add("ExecStartPost", s.ExecStartPost...)
+	result = result.add("ExecReload", s.ExecReload...)
+	result = result.add("ExecStop", s.ExecStop...)
+	result = result.add("Restart", s.Restart)
+	result = result.addDuration("RestartSec", s.RestartSec)
+	result = result.addDuration("TimeoutStartSec", s.TimeoutStartSec)
+	result = result.addDuration("TimeoutStopSec", s.TimeoutStopSec)
+	result = result.add("StandardOutput", s.StandardOutput)
+	result = result.add("StandardError", s.StandardError)
+	result = result.add("SyslogIdentifier", s.SyslogIdentifier)
+	return append(result, s.Extra...)
+}
+
+type TimerSection struct {
+	OnCalendar         []string
+	OnBootSec          time.Duration
+	OnUnitActiveSec    time.Duration
+	AccuracySec        time.Duration
+	RandomizedDelaySec time.Duration
+	Persistent         bool
+	Unit               string
+	Extra              Options
+}
+
+func (t TimerSection) options() Options {
+	var result Options
+	result = result.add("OnCalendar", t.OnCalendar...)
+	result = result.addDuration("OnBootSec", t.OnBootSec)
+	result = result.addDuration("OnUnitActiveSec", t.OnUnitActiveSec)
+	result = result.addDuration("AccuracySec", t.AccuracySec)
+	result = result.addDuration("RandomizedDelaySec", t.RandomizedDelaySec)
+	result = result.addBool("Persistent", t.Persistent)
+	result = result.add("Unit", t.Unit)
+	return append(result, t.Extra...)
+}
+
+type SocketSection struct {
+	ListenStream   []string
+	ListenDatagram []string
+	Accept         bool
+	SocketUser     string
+	SocketGroup    string
+	SocketMode     string
+	Service        string
+	Extra          Options
+}
+
+func (s SocketSection) options() Options {
+	var result Options
+	result = result.add("ListenStream", s.ListenStream...)
+	result = result.add("ListenDatagram", s.ListenDatagram...)
+	result = result.addBool("Accept", s.Accept)
+	result = result.add("SocketUser", s.SocketUser)
+	result = result.add("SocketGroup", s.SocketGroup)
+	result = result.add("SocketMode", s.SocketMode)
+	result = result.add("Service", s.Service)
+	return append(result, s.Extra...)
+}
+
+type InstallSection struct {
+	WantedBy   []string
+	RequiredBy []string
+	Also       []string
+	Extra      Options
+}
+
+func (i InstallSection) options() Options {
+	var result Options
+	result = result.add("WantedBy", i.WantedBy...)
+	result = result.add("RequiredBy", i.RequiredBy...)
+	result = result.add("Also", i.Also...)
+	return append(result, i.Extra...)
+}
+
+// Unit is a systemd unit file. The type of the unit is defined by the suffix of the name.
+type Unit struct {
+	Name    string
+	Unit    UnitSection
+	Service *ServiceSection
+	Timer   *TimerSection
+	Socket  *SocketSection
+	Install *InstallSection
+}
+
+func (u *Unit) Validate(ctx context.Context) error {
+	switch filepath.Ext(u.Name) {
+	case ".service":
+		if u.Service == nil {
+			return errors.Errorf("service section of %s missing", u.Name)
+		}
+	case ".timer":
+		if u.Timer == nil {
+			return errors.Errorf("timer section of %s missing", u.Name)
+		}
+	case ".socket":
+		if u.Socket == nil {
+			return errors.Errorf("socket section of %s missing", u.Name)
+		}
+	case "":
+		return errors.New("Unit name missing")
+	default:
+		return errors.Errorf("unsupported unit type of %s", u.Name)
+	}
+	return nil
+}
+
+// Path of the unit file.
+func (u *Unit) Path(ctx context.Context) (string, error) {
+	return filepath.Join(UnitDirectory, u.Name), nil
+}
+
+func (u *Unit) Content(ctx context.Context) ([]byte, error) {
+	return render(
+		section{name: "Unit", options: u.Unit.options()},
+		u.section("Service", u.Service != nil, func() Options { return u.Service.options() }),
+		u.section("Timer", u.Timer != nil, func() Options { return u.Timer.options() }),
+		u.section("Socket", u.Socket != nil, func() Options { return u.Socket.options() }),
+		u.section("Install", u.Install != nil, func() Options { return u.Install.options() }),
+	), nil
+}
+
+func (u *Unit) section(name string, exists bool, fn func() Options) section {
+	if !exists {
+		return section{}
+	}
+	return section{name: name, options: fn()}
+}
+
+// DropIn overrides parts of an existing unit without replacing it.
+type DropIn struct {
+	Unit    string
+	Name    string
+	Options map[string]Options
+}
+
+func (d *DropIn) Validate(ctx context.Context) error {
+	if d.Unit == "" {
+		return errors.New("Unit missing")
+	}
+	if d.Name == "" {
+		return errors.New("Name missing")
+	}
+	return nil
+}
+
+// Path of the drop-in file.
+func (d *DropIn) Path(ctx context.Context) (string, error) {
+	return filepath.Join(UnitDirectory, fmt.Sprintf("%s.d", d.Unit), fmt.Sprintf("%s.conf", strings.TrimSuffix(d.Name, ".conf"))), nil
+}
+
+func (d *DropIn) Content(ctx context.Context) ([]byte, error) {
+	var sections []section
+	for _, name := range []string{"Unit", "Service", "Timer", "Socket", "Install"} {
+		if options, ok := d.Options[name]; ok {
+			sections = append(sections, section{name: name, options: options})
+		}
+	}
+	if len(sections) != len(d.Options) {
+		return nil, errors.Errorf("drop-in %s contains unknown section", d.Name)
+	}
+	return render(sections...), nil
+}
+
+type section struct {
+	name    string
+	options Options
+}
+
+func render(sections ...section) []byte {
+	buf := &bytes.Buffer{}
+	for _, section := range sections {
+		if section.name == "" {
+			continue
+		}
+		if buf.Len() > 0 {
+			fmt.Fprintln(buf)
+		}
+		fmt.Fprintf(buf, "[%s]\n", section.name)
+		for _, option := range section.options {
+			fmt.Fprintf(buf, "%s=%s\n", option.Key, option.Value)
+		}
+	}
+	return buf.Bytes()
+}
@@ -0,0 +1,82 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package systemd_test
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/bborbe/world/pkg/systemd"
+)
+
+var _ = Describe("Unit", func() {
+	var ctx context.Context
+	BeforeEach(func() {
+		ctx = context.Background()
+	})
+	It("renders service", func() {
+		unit := &systemd.Unit{
+			Name: "banana.service",
+			Unit: systemd.UnitSection{
+				Description: "Banana",
+				After:       []string{"network-online.target"},
+			},
+			Service: &systemd.ServiceSection{
+				Type:       "simple",
+				ExecStart:  []string{"/usr/bin/banana"},
+				Restart:    "always",
+				RestartSec: 20 * time.Second,
+			},
+			Install: &systemd.InstallSection{
+				WantedBy: []string{"multi-user.target"},
+			},
+		}
+		Expect(unit.Validate(ctx)).To(BeNil())
+		content, err := unit.Content(ctx)
+		Expect(err).To(BeNil())
+		Expect(string(content)).To(Equal(`[Unit]
+Description=Banana
+After=network-online.target
+
+[Service]
+Type=simple
+ExecStart=/usr/bin/banana
+Restart=always
+RestartSec=20s
+
+[Install]
+WantedBy=multi-user.target
+`))
+		Expect(unit.Path(ctx)).To(Equal("/etc/systemd/system/banana.service"))
+	})
+	It("requires section matching the unit type", func() {
+		unit := &systemd.Unit{
+			Name: "banana.timer",
+			Service: &systemd.ServiceSection{
+				ExecStart: []string{"/usr/bin/banana"},
+			},
+		}
+		Expect(unit.Validate(ctx)).NotTo(BeNil())
+	})
+	It("renders drop-in", func() {
+		dropIn := &systemd.DropIn{
+			Unit: "docker.service",
+			Name: "override",
+			Options: map[string]systemd.Options{
+				"Service": {
+					{Key: "ExecStart", Value: ""},
+					{Key: "ExecStart", Value: "/usr/bin/dockerd"},
+				},
+			},
+		}
+		Expect(dropIn.Path(ctx)).To(Equal("/etc/systemd/system/docker.service.d/override.conf"))
+		content, err := dropIn.Content(ctx)
+		Expect(err).To(BeNil())
+		Expect(string(content)).To(Equal("[Service]\nExecStart=\nExecStart=/usr/bin/dockerd\n"))
+	})
+})
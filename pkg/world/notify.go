@@ -0,0 +1,72 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package world
+
+import (
+	"context"
+	"sync"
+
+	"github.com/golang/glog"
+	"github.com/pkg/errors"
+)
+
+type notificationsKey struct{}
+
+// notifications collects handlers notified during a run.
+type notifications struct {
+	mux      sync.Mutex
+	keys     []interface{}
+	handlers map[interface{}]func(ctx context.Context) error
+}
+
+func (n *notifications) add(key interface{}, handler func(ctx context.Context) error) {
+	n.mux.Lock()
+	defer n.mux.Unlock()
+	if _, ok := n.handlers[key]; ok {
+		return
+	}
+	n.keys = append(n.keys, key)
+	n.handlers[key] = handler
+}
+
+func (n *notifications) run(ctx context.Context) error {
+	n.mux.Lock()
+	defer n.mux.Unlock()
+	for _, key := range n.keys {
+		glog.V(2).Infof("run handler %v", key)
+		if err := n.handlers[key](ctx); err != nil {
+			return errors.Wrapf(err, "run handler %v failed", key)
+		}
+	}
+	return nil
+}
+
+func (n *notifications) pending() []interface{} {
+	n.mux.Lock()
+	defer n.mux.Unlock()
+	return append([]interface{}{}, n.keys...)
+}
+
+// withNotifications returns a context collecting notifications, if not already present.
+func withNotifications(ctx context.Context) (context.Context, *notifications, bool) {
+	if n, ok := ctx.Value(notificationsKey{}).(*notifications); ok {
+		return ctx, n, false
+	}
+	n := &notifications{
+		handlers: map[interface{}]func(ctx context.Context) error{},
+	}
+	return context.WithValue(ctx, notificationsKey{}, n), n, true
+}
+
+// Notify schedules the handler to run once at the end of the current run.
+// Handlers with the same key run only once. Outside of a run the handler is executed immediately.
+func Notify(ctx context.Context, key interface{}, handler func(ctx context.Context) error) error {
+	n, ok := ctx.Value(notificationsKey{}).(*notifications)
+	if !ok {
+		return handler(ctx)
+	}
+	n.add(key, handler)
+	return nil
+}
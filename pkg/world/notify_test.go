@@ -0,0 +1,92 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package world_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/pkg/errors"
+
+	"github.com/bborbe/world/pkg/world"
+	"github.com/bborbe/world/pkg/world/mocks"
+)
+
+func TestNotifyRunsHandlerOnceAtEnd(t *testing.T) {
+	ctx := context.Background()
+	var events []string
+	notifyingApplier := func(name string) *mocks.Applier {
+		applier := &mocks.Applier{}
+		applier.ApplyStub = func(ctx context.Context) error {
+			events = append(events, name)
+			return world.Notify(ctx, "restart", func(ctx context.Context) error {
+				events = append(events, "restart")
+				return nil
+			})
+		}
+		return applier
+	}
+	builder := world.Builder{
+		Configuration: world.NewConfiguraionBuilder().WithChildren(world.Configurations{
+			world.NewConfiguraionBuilder().WithApplier(notifyingApplier("first")),
+			world.NewConfiguraionBuilder().WithApplier(notifyingApplier("second")),
+		}),
+	}
+	runner, err := builder.Build(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := runner.Apply(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if len(events) != 3 || events[0] != "first" || events[1] != "second" || events[2] != "restart" {
+		t.Fatalf("unexpected events %v", events)
+	}
+}
+
+func TestNotifyWithoutRunRunsImmediately(t *testing.T) {
+	counter := 0
+	err := world.Notify(context.Background(), "restart", func(ctx context.Context) error {
+		counter++
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if counter != 1 {
+		t.Fatalf("handler not executed")
+	}
+}
+
+func TestNotifyRunsHandlerIfLaterApplierFails(t *testing.T) {
+	ctx := context.Background()
+	var events []string
+	notifying := &mocks.Applier{}
+	notifying.ApplyStub = func(ctx context.Context) error {
+		events = append(events, "file")
+		return world.Notify(ctx, "restart", func(ctx context.Context) error {
+			events = append(events, "restart")
+			return nil
+		})
+	}
+	failing := &mocks.Applier{}
+	failing.ApplyReturns(errors.New("banana"))
+	builder := world.Builder{
+		Configuration: world.NewConfiguraionBuilder().WithChildren(world.Configurations{
+			world.NewConfiguraionBuilder().WithApplier(notifying),
+			world.NewConfiguraionBuilder().WithApplier(failing),
+		}),
+	}
+	runner, err := builder.Build(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := runner.Apply(ctx); err == nil {
+		t.Fatal("error expected")
+	}
+	if len(events) != 2 || events[0] != "file" || events[1] != "restart" {
+		t.Fatalf("unexpected events %v", events)
+	}
+}
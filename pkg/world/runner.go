@@ -57,8 +57,35 @@ type Runner struct {
 	Runners []Runner
 }
 
+// Apply the configuration and run all notified handlers afterwards.
+// Handlers notified before a failing applier still run, otherwise their changes would never be picked up.
 func (r Runner) Apply(ctx context.Context) error {
-	return apply(ctx, r, nil)
+	ctx, notifications, owner := withNotifications(ctx)
+	if err := apply(ctx, r, nil); err != nil {
+		if owner {
+			if handlerErr := notifications.run(ctx); handlerErr != nil {
+				glog.Warningf("run handlers after failed apply failed: %v", handlerErr)
+			}
+		}
+		return err
+	}
+	if !owner {
+		return nil
+	}
+	return notifications.run(ctx)
+}
+
+// ApplyFiltered applies all appliers accepted by the filter without checking Satisfied.
+// Children of rejected appliers are visited, notified handlers are not run.
+func (r Runner) ApplyFiltered(ctx context.Context, filter func(applier Applier) bool) error {
+	ctx, notifications, owner := withNotifications(ctx)
+	if err := applyFiltered(ctx, r, nil, filter); err != nil {
+		return err
+	}
+	if pending := notifications.pending(); owner && len(pending) > 0 {
+		glog.V(2).Infof("skip handlers %v", pending)
+	}
+	return nil
 }
 
 func (r Runner) Validate(ctx context.Context) error {
@@ -100,6 +127,23 @@ func apply(ctx context.Context, cfg Runner, path []string) error {
 	return nil
 }
 
+func applyFiltered(ctx context.Context, cfg Runner, path []string, filter func(applier Applier) bool) error {
+	path = append(path, cfg.Name)
+	for _, child := range cfg.Runners {
+		if err := applyFiltered(ctx, child, path, filter); err != nil {
+			return err
+		}
+	}
+	if cfg.Applier == nil || !filter(cfg.Applier) {
+		glog.V(4).Infof("skip configuration %s", strings.Join(path, " -> "))
+		return nil
+	}
+	if err := cfg.Applier.Apply(ctx); err != nil {
+		return errors.Wrapf(err, "apply %s failed", strings.Join(path, " -> "))
+	}
+	return nil
+}
+
 func validate(ctx context.Context, cfg Runner, path []string) error {
 	path = append(path, cfg.Name)
 	glog.V(4).Infof("validate configuration %s", strings.Join(path, " -> "))
@@ -0,0 +1,44 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package world_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/bborbe/world/pkg/world"
+	"github.com/bborbe/world/pkg/world/mocks"
+)
+
+func TestApplyFilteredAppliesOnlyAcceptedAppliers(t *testing.T) {
+	ctx := context.Background()
+	accepted := &mocks.Applier{}
+	accepted.SatisfiedReturns(true, nil)
+	rejected := &mocks.Applier{}
+	builder := world.Builder{
+		Configuration: world.NewConfiguraionBuilder().WithApplier(rejected).WithChildren(world.Configurations{
+			world.NewConfiguraionBuilder().WithApplier(accepted),
+		}),
+	}
+	runner, err := builder.Build(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = runner.ApplyFiltered(ctx, func(applier world.Applier) bool {
+		return applier == accepted
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if accepted.ApplyCallCount() != 1 {
+		t.Fatalf("accepted applier not applied")
+	}
+	if accepted.SatisfiedCallCount() != 0 {
+		t.Fatalf("satisfied of accepted applier called")
+	}
+	if rejected.ApplyCallCount() != 0 {
+		t.Fatalf("rejected applier applied")
+	}
+}
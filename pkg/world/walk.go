@@ -0,0 +1,28 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package world
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+)
+
+// Walk calls fn for the configuration and all its children depth first.
+func Walk(ctx context.Context, configuration Configuration, fn func(configuration Configuration) error) error {
+	if err := fn(configuration); err != nil {
+		return err
+	}
+	children, err := configuration.Children(ctx)
+	if err != nil {
+		return errors.Wrap(err, "get children failed")
+	}
+	for _, child := range children {
+		if err := Walk(ctx, child, fn); err != nil {
+			return err
+		}
+	}
+	return nil
+}
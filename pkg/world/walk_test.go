@@ -0,0 +1,45 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package world_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/bborbe/teamvault-utils/v4"
+
+	"github.com/bborbe/world/configuration"
+	"github.com/bborbe/world/configuration/service"
+	"github.com/bborbe/world/pkg/hetzner"
+	"github.com/bborbe/world/pkg/secret"
+	"github.com/bborbe/world/pkg/world"
+)
+
+func TestWalk(t *testing.T) {
+	ctx := context.Background()
+	var timers []*service.Timer
+	err := world.Walk(ctx, &configuration.World{
+		HetznerClient: hetzner.NewCLientDummy(),
+		TeamvaultSecrets: &secret.Teamvault{
+			TeamvaultConnector: teamvault.NewDummyConnector(),
+		},
+	}, func(configuration world.Configuration) error {
+		if timer, ok := configuration.(*service.Timer); ok {
+			timers = append(timers, timer)
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(timers) == 0 {
+		t.Fatal("no timers found")
+	}
+	for _, timer := range timers {
+		if _, err := timer.Schedule.Parse(); err != nil {
+			t.Fatalf("parse schedule of %s failed: %v", timer.Name, err)
+		}
+	}
+}